@@ -93,7 +93,7 @@ class DecoyPromptCreationScreen extends React.Component {
             defaultValue="Enter a decoy prompt for this drawing:"
           />
         </h3>
-        <img className="promptImage" src={currentDrawing.imageData} alt="a drawing" />
+        <img className="promptImage" src={currentDrawing.imageUrl} alt="a drawing" />
         <div className="promptFieldContainer">
           <label htmlFor="adjective1">
             <FormattedMessage
@@ -167,7 +167,7 @@ DecoyPromptCreationScreen.propTypes = {
       hasCompletedAction: PropTypes.bool.isRequired,
     }).isRequired,
     currentDrawing: PropTypes.shape({
-      imageData: PropTypes.string.isRequired,
+      imageUrl: PropTypes.string.isRequired,
     }),
     players: PropTypes.arrayOf(PropTypes.shape({
       name: PropTypes.string.isRequired,
@@ -26,6 +26,8 @@ class Game extends React.Component {
     // Split the browser's locale string to get the language without the region
     const currentLanguage = navigator.language.split(/[-_]/)[0];
     super(props);
+    // Server error messages follow the language picked in the game
+    axios.defaults.headers.common['Accept-Language'] = currentLanguage;
     this.state = {
       currentLanguage,
       consoleEnabled: false,
@@ -42,8 +44,21 @@ class Game extends React.Component {
     this.onLocaleChanged = this.onLanguageSelected.bind(this);
   }
 
-  onLanguageSelected(currentLanguage) {
+  async onLanguageSelected(currentLanguage) {
+    axios.defaults.headers.common['Accept-Language'] = currentLanguage;
     this.setState({ currentLanguage });
+    // Remember the language for the player so every server message uses it
+    const { gameState } = this.state;
+    const { groupName, currentPlayer } = gameState;
+    if (currentPlayer) {
+      try {
+        const data = { groupName, playerName: currentPlayer.name, language: currentLanguage };
+        const response = await axios.post('/api/set-language', data);
+        this.setState({ gameState: response.data });
+      } catch (error) {
+        this.setState({ error: formatServerError(error) });
+      }
+    }
   }
 
   onGameEntered(gameState) {
@@ -147,7 +147,9 @@ class ScoringScreen extends React.Component {
     const playerScores = ScoringScreen.formatPlayerScores(pointStandings, currentPlayerName);
     const pastDrawingItems = pastDrawings.map((drawing) => (
       <div className="pastDrawingContainer" key={drawing.originalPrompt}>
-        <img className="pastDrawing" src={drawing.imageData} alt="a drawing" />
+        <a href={drawing.imageUrl} target="_blank" rel="noopener noreferrer">
+          <img className="pastDrawing" src={drawing.thumbnailUrl} alt="a drawing" />
+        </a>
         <span>
           <FormattedMessage
             id="scoringScreen.pastDrawingDescriptionFormat"
@@ -164,7 +166,7 @@ class ScoringScreen extends React.Component {
     ));
     return (
       <div className="screen votingScreen">
-        <img className="promptImage" src={currentDrawing.imageData} alt="a drawing" />
+        <img className="promptImage" src={currentDrawing.imageUrl} alt="a drawing" />
         <p>
           <FormattedMessage
             id="scoringScreen.promptAuthorFormat"
@@ -228,7 +230,8 @@ class ScoringScreen extends React.Component {
 }
 
 const drawingProptype = PropTypes.shape({
-  imageData: PropTypes.string.isRequired,
+  imageUrl: PropTypes.string.isRequired,
+  thumbnailUrl: PropTypes.string,
   originalPrompt: PropTypes.shape({
     noun: PropTypes.string.isRequired,
     adjectives: PropTypes.arrayOf(PropTypes.string).isRequired,
@@ -150,7 +150,7 @@ class VotingScreen extends React.Component {
     );
     return (
       <div className="screen voteSelection">
-        <img className="promptImage" src={currentDrawing.imageData} alt="a drawing" />
+        <img className="promptImage" src={currentDrawing.imageUrl} alt="a drawing" />
         {currentPlayer.hasCompletedAction ? waitingElements : votingElements}
         <h3 className="error">{error}</h3>
       </div>
@@ -166,7 +166,7 @@ VotingScreen.propTypes = {
       hasCompletedAction: PropTypes.bool.isRequired,
     }).isRequired,
     currentDrawing: PropTypes.shape({
-      imageData: PropTypes.string.isRequired,
+      imageUrl: PropTypes.string.isRequired,
       prompts: PropTypes.arrayOf(PropTypes.shape({
         identifier: PropTypes.string.isRequired,
         noun: PropTypes.string.isRequired,
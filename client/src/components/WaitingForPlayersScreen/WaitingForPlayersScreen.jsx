@@ -14,6 +14,7 @@ class WaitingForPlayersScreen extends React.Component {
     };
     this.updateGameState = this.updateGameState.bind(this);
     this.onStartGameButtonClicked = this.onStartGameButtonClicked.bind(this);
+    this.onAddBotButtonClicked = this.onAddBotButtonClicked.bind(this);
   }
 
   componentDidMount() {
@@ -41,6 +42,19 @@ class WaitingForPlayersScreen extends React.Component {
     }
   }
 
+  async onAddBotButtonClicked() {
+    const { gameState, onGameStateChanged } = this.props;
+    const { groupName, currentPlayer } = gameState;
+    const { name } = currentPlayer;
+    const data = { playerName: name, groupName };
+    try {
+      const response = await axios.post('/api/add-bot', data);
+      onGameStateChanged(response.data);
+    } catch (error) {
+      this.setState({ error: formatServerError(error) });
+    }
+  }
+
   updateGameState() {
     const { gameState, onGameStateChanged } = this.props;
     const { groupName, currentPlayer } = gameState;
@@ -62,6 +76,12 @@ class WaitingForPlayersScreen extends React.Component {
       <li key={player.name}>
         {player.name}
         {player.name === currentPlayerName ? '*' : null}
+        {player.bot ? (
+          <FormattedMessage
+            id="waitingForPlayersScreen.botLabel"
+            defaultMessage=" (bot)"
+          />
+        ) : null}
       </li>
     ));
     return (
@@ -75,12 +95,20 @@ class WaitingForPlayersScreen extends React.Component {
         </h1>
         <ul>{playerList}</ul>
         { isHost ? (
-          <button type="button" className="buttonTypeA" onClick={this.onStartGameButtonClicked}>
-            <FormattedMessage
-              id="waitingForPlayersScreen.startGameButton"
-              defaultMessage="Start"
-            />
-          </button>
+          <div>
+            <button type="button" className="buttonTypeA" onClick={this.onAddBotButtonClicked}>
+              <FormattedMessage
+                id="waitingForPlayersScreen.addBotButton"
+                defaultMessage="Add a bot"
+              />
+            </button>
+            <button type="button" className="buttonTypeA" onClick={this.onStartGameButtonClicked}>
+              <FormattedMessage
+                id="waitingForPlayersScreen.startGameButton"
+                defaultMessage="Start"
+              />
+            </button>
+          </div>
         )
           : (
             <h3>
@@ -104,6 +132,7 @@ WaitingForPlayersScreen.propTypes = {
     }).isRequired,
     players: PropTypes.arrayOf(PropTypes.shape({
       name: PropTypes.string.isRequired,
+      bot: PropTypes.bool,
     })),
     groupName: PropTypes.string.isRequired,
   }).isRequired,
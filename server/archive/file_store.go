@@ -0,0 +1,120 @@
+package archive
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var validGameID = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// FileStore keeps each transcript as a JSON file, grouped in a directory per group
+type FileStore struct {
+	mutex     sync.RWMutex
+	directory string
+}
+
+// NewFileStore creates a store that keeps transcripts under the given directory
+func NewFileStore(directory string) (*FileStore, error) {
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{directory: directory}, nil
+}
+
+// SaveTranscript writes a transcript to disk, replacing any previous version of it
+func (fileStore *FileStore) SaveTranscript(transcript *Transcript) error {
+	if !validGameID.MatchString(transcript.GameID) {
+		return errors.New("invalid game id")
+	}
+	data, err := json.Marshal(transcript)
+	if err != nil {
+		return err
+	}
+	fileStore.mutex.Lock()
+	defer fileStore.mutex.Unlock()
+	groupDirectory := fileStore.groupDirectory(transcript.GroupName)
+	err = os.MkdirAll(groupDirectory, 0755)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so a crash never leaves a partially written transcript
+	temporaryFile, err := ioutil.TempFile(groupDirectory, ".transcript-")
+	if err != nil {
+		return err
+	}
+	_, err = temporaryFile.Write(data)
+	if closeErr := temporaryFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(temporaryFile.Name())
+		return err
+	}
+	return os.Rename(temporaryFile.Name(), filepath.Join(groupDirectory, transcript.GameID+".json"))
+}
+
+// LoadTranscript reads a transcript from disk
+func (fileStore *FileStore) LoadTranscript(groupName string, gameID string) (*Transcript, error) {
+	if !validGameID.MatchString(gameID) {
+		return nil, ErrTranscriptNotFound
+	}
+	fileStore.mutex.RLock()
+	defer fileStore.mutex.RUnlock()
+	return readTranscript(filepath.Join(fileStore.groupDirectory(groupName), gameID+".json"))
+}
+
+// ListTranscripts reads the summaries of a group's transcripts from disk
+func (fileStore *FileStore) ListTranscripts(groupName string) ([]*Summary, error) {
+	fileStore.mutex.RLock()
+	defer fileStore.mutex.RUnlock()
+	groupDirectory := fileStore.groupDirectory(groupName)
+	files, err := ioutil.ReadDir(groupDirectory)
+	if os.IsNotExist(err) {
+		return []*Summary{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]*Summary, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		transcript, err := readTranscript(filepath.Join(groupDirectory, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, transcript.Summarize())
+	}
+	sortSummaries(summaries)
+	return summaries, nil
+}
+
+// Group names are picked by players so they're hex encoded to be safe to use as directory names
+func (fileStore *FileStore) groupDirectory(groupName string) string {
+	return filepath.Join(fileStore.directory, hex.EncodeToString([]byte(groupName)))
+}
+
+func readTranscript(path string) (*Transcript, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrTranscriptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	transcript := &Transcript{}
+	err = json.Unmarshal(data, transcript)
+	if err != nil {
+		return nil, err
+	}
+	return transcript, nil
+}
@@ -0,0 +1,96 @@
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates a directory that's removed after the test, t.TempDir needs Go 1.15 and CI runs 1.14
+func tempDirectory(t *testing.T) string {
+	directory, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatalf("Failed to create a temporary directory: %v", err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(directory)
+	})
+	return directory
+}
+
+func testTranscript(gameID string, groupName string, startedAt time.Time) *Transcript {
+	return &Transcript{
+		GameID:    gameID,
+		GroupName: groupName,
+		StartedAt: startedAt,
+		Players:   []*PlayerScore{{Name: "mama cat", Points: 4}},
+		Rounds: []*Round{{
+			Number: 1,
+			Drawings: []*Drawing{{
+				Author:         "mama cat",
+				ImageID:        "abc",
+				ImageURL:       "/api/images/abc",
+				OriginalPrompt: &Prompt{Identifier: "1", Noun: "tuna", Adjectives: []string{"stinky", "yummy"}},
+				DecoyPrompts:   []*Prompt{{Identifier: "2", Author: "baby cat", Noun: "salmon", Adjectives: []string{"big", "red"}}},
+				Votes:          []*Vote{{Player: "baby cat", PromptIdentifier: "1"}},
+				Points:         []*Points{{Player: "baby cat", Amount: 3, Reason: "ChoseCorrectPrompt", CausingPlayer: "baby cat"}},
+			}},
+		}},
+	}
+}
+
+func TestFileStore_SaveAndLoadTranscript(t *testing.T) {
+	fileStore, err := NewFileStore(tempDirectory(t))
+	assert.Nil(t, err)
+	transcript := testTranscript("1", "../cat party", time.Date(2020, 5, 1, 20, 0, 0, 0, time.UTC))
+	err = fileStore.SaveTranscript(transcript)
+	assert.Nil(t, err)
+	loadedTranscript, err := fileStore.LoadTranscript(transcript.GroupName, transcript.GameID)
+	assert.Nil(t, err)
+	assert.EqualValues(t, transcript, loadedTranscript)
+	// Saving again replaces the transcript
+	finishedAt := time.Date(2020, 5, 1, 21, 0, 0, 0, time.UTC)
+	transcript.FinishedAt = &finishedAt
+	err = fileStore.SaveTranscript(transcript)
+	assert.Nil(t, err)
+	loadedTranscript, err = fileStore.LoadTranscript(transcript.GroupName, transcript.GameID)
+	assert.Nil(t, err)
+	assert.EqualValues(t, transcript, loadedTranscript)
+}
+
+func TestFileStore_LoadTranscript_Missing(t *testing.T) {
+	fileStore, _ := NewFileStore(tempDirectory(t))
+	fileStore.SaveTranscript(testTranscript("1", "cat party", time.Now()))
+	_, err := fileStore.LoadTranscript("cat party", "2")
+	assert.Equal(t, ErrTranscriptNotFound, err)
+	_, err = fileStore.LoadTranscript("dog party", "1")
+	assert.Equal(t, ErrTranscriptNotFound, err)
+	_, err = fileStore.LoadTranscript("cat party", "../1")
+	assert.Equal(t, ErrTranscriptNotFound, err)
+}
+
+func TestFileStore_ListTranscripts(t *testing.T) {
+	fileStore, _ := NewFileStore(tempDirectory(t))
+	firstGame := testTranscript("1", "cat party", time.Date(2020, 5, 1, 20, 0, 0, 0, time.UTC))
+	secondGame := testTranscript("2", "cat party", time.Date(2020, 5, 2, 20, 0, 0, 0, time.UTC))
+	otherGroupGame := testTranscript("3", "dog party", time.Date(2020, 5, 3, 20, 0, 0, 0, time.UTC))
+	for _, transcript := range []*Transcript{secondGame, otherGroupGame, firstGame} {
+		assert.Nil(t, fileStore.SaveTranscript(transcript))
+	}
+	summaries, err := fileStore.ListTranscripts("cat party")
+	assert.Nil(t, err)
+	assert.EqualValues(t, []*Summary{firstGame.Summarize(), secondGame.Summarize()}, summaries)
+	// Groups without archived games have an empty history
+	summaries, err = fileStore.ListTranscripts("bird party")
+	assert.Nil(t, err)
+	assert.Empty(t, summaries)
+}
+
+func TestFileStore_SaveTranscript_InvalidGameID(t *testing.T) {
+	fileStore, _ := NewFileStore(tempDirectory(t))
+	err := fileStore.SaveTranscript(testTranscript("../1", "cat party", time.Now()))
+	assert.NotNil(t, err)
+}
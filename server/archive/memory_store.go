@@ -0,0 +1,59 @@
+package archive
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryStore keeps transcripts in memory for as long as the server runs
+type MemoryStore struct {
+	mutex       sync.RWMutex
+	transcripts map[string]map[string]*Transcript
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{transcripts: map[string]map[string]*Transcript{}}
+}
+
+// SaveTranscript adds or replaces a transcript in memory
+func (memoryStore *MemoryStore) SaveTranscript(transcript *Transcript) error {
+	memoryStore.mutex.Lock()
+	defer memoryStore.mutex.Unlock()
+	groupTranscripts, found := memoryStore.transcripts[transcript.GroupName]
+	if !found {
+		groupTranscripts = map[string]*Transcript{}
+		memoryStore.transcripts[transcript.GroupName] = groupTranscripts
+	}
+	groupTranscripts[transcript.GameID] = transcript
+	return nil
+}
+
+// LoadTranscript loads a transcript from memory
+func (memoryStore *MemoryStore) LoadTranscript(groupName string, gameID string) (*Transcript, error) {
+	memoryStore.mutex.RLock()
+	defer memoryStore.mutex.RUnlock()
+	transcript, found := memoryStore.transcripts[groupName][gameID]
+	if !found {
+		return nil, ErrTranscriptNotFound
+	}
+	return transcript, nil
+}
+
+// ListTranscripts lists the summaries of a group's transcripts in memory
+func (memoryStore *MemoryStore) ListTranscripts(groupName string) ([]*Summary, error) {
+	memoryStore.mutex.RLock()
+	defer memoryStore.mutex.RUnlock()
+	summaries := make([]*Summary, 0, len(memoryStore.transcripts[groupName]))
+	for _, transcript := range memoryStore.transcripts[groupName] {
+		summaries = append(summaries, transcript.Summarize())
+	}
+	sortSummaries(summaries)
+	return summaries, nil
+}
+
+func sortSummaries(summaries []*Summary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].StartedAt.Before(summaries[j].StartedAt)
+	})
+}
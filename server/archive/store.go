@@ -0,0 +1,38 @@
+package archive
+
+import (
+	"errors"
+	"sync"
+)
+
+var once sync.Once
+
+// ErrTranscriptNotFound is returned when loading a transcript that was never archived
+var ErrTranscriptNotFound = errors.New("could not find an archived game with that id")
+
+// Store defines the interface different providers of game history storage implement
+type Store interface {
+	SaveTranscript(transcript *Transcript) error
+	LoadTranscript(groupName string, gameID string) (*Transcript, error)
+	// ListTranscripts returns the summaries of a group's archived games, oldest first
+	ListTranscripts(groupName string) ([]*Summary, error)
+}
+
+var (
+	store Store = nil
+)
+
+// GetStore gets the store to be used for archiving games
+func GetStore() Store {
+	once.Do(func() {
+		if store == nil {
+			store = NewMemoryStore()
+		}
+	})
+	return store
+}
+
+// SetStore changes the store to be used for archiving games
+func SetStore(newStore Store) {
+	store = newStore
+}
@@ -0,0 +1,98 @@
+package archive
+
+import (
+	"time"
+)
+
+// Transcript is the archived history of a single game, built up as its rounds are completed
+type Transcript struct {
+	GameID     string         `json:"gameId"`
+	GroupName  string         `json:"groupName"`
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt *time.Time     `json:"finishedAt"`
+	Players    []*PlayerScore `json:"players"`
+	Rounds     []*Round       `json:"rounds"`
+}
+
+// PlayerScore is a player's total score as of the last archived round
+type PlayerScore struct {
+	Name   string `json:"name"`
+	Points uint64 `json:"points"`
+}
+
+// Round contains every drawing made during a round of the game
+type Round struct {
+	Number   uint       `json:"number"`
+	Drawings []*Drawing `json:"drawings"`
+}
+
+// Drawing is a drawing along with the prompts and votes players made for it
+type Drawing struct {
+	Author         string    `json:"author"`
+	ImageID        string    `json:"imageId"`
+	ImageURL       string    `json:"imageUrl"`
+	Strokes        []*Stroke `json:"strokes,omitempty"`
+	TimelapseID    string    `json:"timelapseId,omitempty"`
+	OriginalPrompt *Prompt   `json:"originalPrompt"`
+	DecoyPrompts   []*Prompt `json:"decoyPrompts"`
+	Votes          []*Vote   `json:"votes"`
+	Points         []*Points `json:"points"`
+}
+
+// Stroke is a line of a drawing that was submitted as stroke data
+type Stroke struct {
+	Color  string        `json:"color"`
+	Width  float64       `json:"width"`
+	Points []StrokePoint `json:"points"`
+}
+
+// StrokePoint is a point of a stroke along with when it was drawn, in milliseconds since the drawing was started
+type StrokePoint struct {
+	X    float64 `json:"x"`
+	Y    float64 `json:"y"`
+	Time int64   `json:"time"`
+}
+
+// Prompt is either the prompt a drawing was made from or a decoy written by a player
+type Prompt struct {
+	Identifier string   `json:"identifier"`
+	Author     string   `json:"author"`
+	Noun       string   `json:"noun"`
+	Adjectives []string `json:"adjectives"`
+}
+
+// Vote is the prompt a player picked for a drawing
+type Vote struct {
+	Player           string `json:"player"`
+	PromptIdentifier string `json:"promptIdentifier"`
+}
+
+// Points describes points a player earned from a drawing
+type Points struct {
+	Player        string `json:"player"`
+	Amount        uint64 `json:"amount"`
+	Reason        string `json:"reason"`
+	CausingPlayer string `json:"causingPlayer"`
+}
+
+// Summary describes an archived game without the contents of its rounds
+type Summary struct {
+	GameID     string         `json:"gameId"`
+	GroupName  string         `json:"groupName"`
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt *time.Time     `json:"finishedAt"`
+	Players    []*PlayerScore `json:"players"`
+	RoundCount int            `json:"roundCount"`
+}
+
+// Summarize creates the summary of a transcript
+func (transcript *Transcript) Summarize() *Summary {
+	return &Summary{
+		GameID:     transcript.GameID,
+		GroupName:  transcript.GroupName,
+		StartedAt:  transcript.StartedAt,
+		FinishedAt: transcript.FinishedAt,
+		Players:    transcript.Players,
+		RoundCount: len(transcript.Rounds),
+	}
+}
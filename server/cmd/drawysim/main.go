@@ -0,0 +1,54 @@
+// Command drawysim plays simulated games against a drawydraw server and reports request latencies, errors and the server's memory use.
+// Without -url it plays against a server running in the same process.
+package main
+
+import (
+	"drawydraw/httpapi"
+	"drawydraw/logging"
+	"drawydraw/simulation"
+	"flag"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	serverURL := flag.String("url", "", "URL of the server to play against, a server is started in process when it's empty")
+	groups := flag.Int("groups", 10, "number of games played at the same time")
+	players := flag.Int("players", 4, "number of players in each game")
+	rounds := flag.Uint("rounds", 1, "number of rounds in each game")
+	pollInterval := flag.Duration("poll", 500*time.Millisecond, "how long players wait between game status checks")
+	timeout := flag.Duration("timeout", 5*time.Minute, "how long games have to finish")
+	flag.Parse()
+
+	config := simulation.Config{
+		URL:          strings.TrimSuffix(*serverURL, "/"),
+		Groups:       *groups,
+		Players:      *players,
+		Rounds:       *rounds,
+		PollInterval: *pollInterval,
+		Timeout:      *timeout,
+	}
+	if config.URL == "" {
+		// Request logs would drown out the report
+		gin.SetMode(gin.ReleaseMode)
+		gin.DefaultWriter = ioutil.Discard
+		logging.SetLogger(logging.NewLogger(ioutil.Discard, logging.LevelError))
+		server := httptest.NewServer(httpapi.NewRouter())
+		defer server.Close()
+		config.URL = server.URL
+	}
+	report, err := simulation.Run(config)
+	if err != nil {
+		log.Fatalf("Failed to run the simulation: %s", err.Error())
+	}
+	report.Write(os.Stdout)
+	if report.GroupsCompleted < report.Groups {
+		log.Fatalf("%d games against %s did not finish", report.Groups-report.GroupsCompleted, config.URL)
+	}
+}
@@ -0,0 +1,243 @@
+package export
+
+import (
+	"archive/zip"
+	"drawydraw/archive"
+	"drawydraw/images"
+	"drawydraw/models"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Data the gallery template is rendered with
+type galleryPage struct {
+	GroupName string
+	StartedAt string
+	Scores    []*archive.PlayerScore
+	Rounds    []*galleryRound
+}
+
+type galleryRound struct {
+	Number   uint
+	Drawings []*galleryDrawing
+}
+
+type galleryDrawing struct {
+	ImagePath      string
+	TimelapsePath  string
+	Author         string
+	OriginalPrompt *galleryPrompt
+	DecoyPrompts   []*galleryPrompt
+	Points         []*archive.Points
+}
+
+type galleryPrompt struct {
+	Text   string
+	Author string
+	Voters []string
+}
+
+// WriteGallery writes a ZIP file with every drawing of an archived game as a PNG and an index.html
+// gallery showing each drawing's prompts, votes and points along with the final scores.
+// Drawings that were submitted as strokes also get a GIF timelapse of them being drawn.
+func WriteGallery(transcript *archive.Transcript, drawingStore images.DrawingStore, writer io.Writer) error {
+	zipWriter := zip.NewWriter(writer)
+	page := &galleryPage{
+		GroupName: transcript.GroupName,
+		StartedAt: transcript.StartedAt.Format("January 2, 2006"),
+		Scores:    append([]*archive.PlayerScore{}, transcript.Players...),
+		Rounds:    make([]*galleryRound, len(transcript.Rounds)),
+	}
+	sort.SliceStable(page.Scores, func(i, j int) bool { return page.Scores[i].Points > page.Scores[j].Points })
+	for i, round := range transcript.Rounds {
+		page.Rounds[i] = &galleryRound{Number: round.Number, Drawings: make([]*galleryDrawing, len(round.Drawings))}
+		for j, drawing := range round.Drawings {
+			imagePath := fmt.Sprintf("drawings/round-%d-drawing-%d.png", round.Number, j+1)
+			written, err := writeDrawingImage(zipWriter, imagePath, drawing, drawingStore)
+			if err != nil {
+				return err
+			}
+			// Drawings that can't be converted to PNG are listed without their image
+			if !written {
+				imagePath = ""
+			}
+			page.Rounds[i].Drawings[j] = galleryDrawingFromDrawing(drawing, imagePath)
+			if len(drawing.Strokes) > 0 {
+				timelapsePath := fmt.Sprintf("drawings/round-%d-drawing-%d.gif", round.Number, j+1)
+				written, err = writeDrawingTimelapse(zipWriter, timelapsePath, drawing, drawingStore)
+				if err != nil {
+					return err
+				}
+				if written {
+					page.Rounds[i].Drawings[j].TimelapsePath = timelapsePath
+				}
+			}
+		}
+	}
+	indexWriter, err := zipWriter.Create("index.html")
+	if err != nil {
+		return err
+	}
+	err = galleryTemplate.Execute(indexWriter, page)
+	if err != nil {
+		return err
+	}
+	return zipWriter.Close()
+}
+
+func writeDrawingImage(zipWriter *zip.Writer, path string, drawing *archive.Drawing, drawingStore images.DrawingStore) (bool, error) {
+	storedDrawing, err := drawingStore.LoadDrawing(drawing.ImageID)
+	if err == images.ErrDrawingNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	data := storedDrawing.Data
+	if storedDrawing.MediaType != "image/png" {
+		data, err = images.ConvertToPNG(data)
+		if err != nil {
+			return false, nil
+		}
+	}
+	fileWriter, err := zipWriter.Create(path)
+	if err != nil {
+		return false, err
+	}
+	_, err = fileWriter.Write(data)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func writeDrawingTimelapse(zipWriter *zip.Writer, path string, drawing *archive.Drawing, drawingStore images.DrawingStore) (bool, error) {
+	timelapse, err := loadDrawingTimelapse(drawing, drawingStore)
+	if err != nil {
+		return false, err
+	}
+	if timelapse == nil {
+		return false, nil
+	}
+	fileWriter, err := zipWriter.Create(path)
+	if err != nil {
+		return false, err
+	}
+	_, err = fileWriter.Write(timelapse)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Drawings have their timelapse stored when they're submitted, ones archived before that are rendered from their strokes
+func loadDrawingTimelapse(drawing *archive.Drawing, drawingStore images.DrawingStore) ([]byte, error) {
+	if drawing.TimelapseID != "" {
+		storedTimelapse, err := drawingStore.LoadDrawing(drawing.TimelapseID)
+		if err == nil {
+			return storedTimelapse.Data, nil
+		}
+		if err != images.ErrDrawingNotFound {
+			return nil, err
+		}
+	}
+	strokes := make([]*models.Stroke, len(drawing.Strokes))
+	for i, stroke := range drawing.Strokes {
+		strokes[i] = &models.Stroke{Color: stroke.Color, Width: stroke.Width}
+		for _, point := range stroke.Points {
+			strokes[i].Points = append(strokes[i].Points, models.StrokePoint(point))
+		}
+	}
+	// Strokes were validated when they were submitted, but a bad transcript shouldn't sink the whole export
+	if images.ValidateStrokes(strokes) != nil {
+		return nil, nil
+	}
+	timelapse, err := images.RenderTimelapse(strokes, images.GetTimelapseOptions())
+	if err != nil {
+		return nil, nil
+	}
+	return timelapse, nil
+}
+
+func galleryDrawingFromDrawing(drawing *archive.Drawing, imagePath string) *galleryDrawing {
+	votersByPrompt := map[string][]string{}
+	for _, vote := range drawing.Votes {
+		votersByPrompt[vote.PromptIdentifier] = append(votersByPrompt[vote.PromptIdentifier], vote.Player)
+	}
+	galleryDrawing := &galleryDrawing{
+		ImagePath:      imagePath,
+		Author:         drawing.Author,
+		OriginalPrompt: galleryPromptFromPrompt(drawing.OriginalPrompt, votersByPrompt),
+		DecoyPrompts:   make([]*galleryPrompt, len(drawing.DecoyPrompts)),
+		Points:         drawing.Points,
+	}
+	for i, decoyPrompt := range drawing.DecoyPrompts {
+		galleryDrawing.DecoyPrompts[i] = galleryPromptFromPrompt(decoyPrompt, votersByPrompt)
+	}
+	return galleryDrawing
+}
+
+func galleryPromptFromPrompt(prompt *archive.Prompt, votersByPrompt map[string][]string) *galleryPrompt {
+	words := append(append([]string{}, prompt.Adjectives...), prompt.Noun)
+	return &galleryPrompt{
+		Text:   strings.Join(words, " "),
+		Author: prompt.Author,
+		Voters: votersByPrompt[prompt.Identifier],
+	}
+}
+
+var galleryTemplate = template.Must(template.New("gallery").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Drawy draw - {{.GroupName}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #333; }
+.drawing { display: inline-block; vertical-align: top; width: 320px; margin: 0 1em 2em 0; }
+.drawing img { width: 100%; border: 1px solid #ccc; }
+.original { font-weight: bold; }
+.voters { color: #777; font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>{{.GroupName}}</h1>
+<p>Played on {{.StartedAt}}</p>
+<h2>Final scores</h2>
+<ol>
+{{- range .Scores}}
+<li>{{.Name}}: {{.Points}} points</li>
+{{- end}}
+</ol>
+{{- range .Rounds}}
+<h2>Round {{.Number}}</h2>
+{{- range .Drawings}}
+<div class="drawing">
+{{- if .ImagePath}}
+<img src="{{.ImagePath}}" alt="Drawing by {{.Author}}">
+{{- else}}
+<p>Image not available</p>
+{{- end}}
+<p>Drawn by {{.Author}}{{with .TimelapsePath}} (<a href="{{.}}">watch it being drawn</a>){{end}}</p>
+<ul>
+<li class="original">{{.OriginalPrompt.Text}}{{with .OriginalPrompt.Voters}} <span class="voters">picked by {{range $i, $voter := .}}{{if $i}}, {{end}}{{$voter}}{{end}}</span>{{end}}</li>
+{{- range .DecoyPrompts}}
+<li>{{.Text}} (decoy by {{.Author}}){{with .Voters}} <span class="voters">picked by {{range $i, $voter := .}}{{if $i}}, {{end}}{{$voter}}{{end}}</span>{{end}}</li>
+{{- end}}
+</ul>
+{{- with .Points}}
+<p>Points:</p>
+<ul>
+{{- range .}}
+<li>{{.Player}} +{{.Amount}} ({{.Reason}}, {{.CausingPlayer}})</li>
+{{- end}}
+</ul>
+{{- end}}
+</div>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"drawydraw/archive"
+	"drawydraw/images"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func storedTestImage(t *testing.T, drawingStore images.DrawingStore, mediaType string) string {
+	testImage := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	testImage.Set(1, 1, color.RGBA{R: 255, A: 255})
+	buffer := bytes.Buffer{}
+	if mediaType == "image/jpeg" {
+		assert.Nil(t, jpeg.Encode(&buffer, testImage, nil))
+	} else {
+		assert.Nil(t, png.Encode(&buffer, testImage))
+	}
+	imageID, err := drawingStore.SaveDrawing(&images.StoredDrawing{MediaType: mediaType, Data: buffer.Bytes()})
+	assert.Nil(t, err)
+	return imageID
+}
+
+func readZip(t *testing.T, data []byte) map[string][]byte {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.Nil(t, err)
+	files := map[string][]byte{}
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		assert.Nil(t, err)
+		files[file.Name], err = ioutil.ReadAll(reader)
+		assert.Nil(t, err)
+		reader.Close()
+	}
+	return files
+}
+
+func TestWriteGallery(t *testing.T) {
+	drawingStore := images.NewMemoryDrawingStore()
+	pngImage := storedTestImage(t, drawingStore, "image/png")
+	transcript := &archive.Transcript{
+		GameID:    "1",
+		GroupName: "cat party",
+		StartedAt: time.Date(2020, 5, 1, 20, 0, 0, 0, time.UTC),
+		Players:   []*archive.PlayerScore{{Name: "baby cat", Points: 1}, {Name: "mama cat", Points: 3}},
+		Rounds: []*archive.Round{{
+			Number: 1,
+			Drawings: []*archive.Drawing{
+				{
+					Author:         "mama cat",
+					ImageID:        pngImage,
+					OriginalPrompt: &archive.Prompt{Identifier: "1", Noun: "tuna", Adjectives: []string{"stinky", "yummy"}},
+					DecoyPrompts:   []*archive.Prompt{{Identifier: "2", Author: "baby cat", Noun: "salmon", Adjectives: []string{"big", "red"}}},
+					Votes:          []*archive.Vote{{Player: "papa cat", PromptIdentifier: "2"}},
+					Points:         []*archive.Points{{Player: "baby cat", Amount: 1, Reason: "FooledPlayer", CausingPlayer: "papa cat"}},
+				},
+				{
+					Author:         "baby cat",
+					ImageID:        storedTestImage(t, drawingStore, "image/jpeg"),
+					OriginalPrompt: &archive.Prompt{Identifier: "3", Noun: "mouse", Adjectives: []string{"fast", "tiny"}},
+				},
+				{
+					Author:         "papa cat",
+					ImageID:        images.DrawingID([]byte("missing image")),
+					OriginalPrompt: &archive.Prompt{Identifier: "4", Noun: "yarn", Adjectives: []string{"soft", "blue"}},
+				},
+			},
+		}},
+	}
+	gallery := bytes.Buffer{}
+	err := WriteGallery(transcript, drawingStore, &gallery)
+	assert.Nil(t, err)
+	files := readZip(t, gallery.Bytes())
+	assert.Len(t, files, 3)
+	// PNG drawings are kept as they are and other formats are converted
+	storedPNG, _ := drawingStore.LoadDrawing(pngImage)
+	assert.Equal(t, storedPNG.Data, files["drawings/round-1-drawing-1.png"])
+	_, err = png.Decode(bytes.NewReader(files["drawings/round-1-drawing-2.png"]))
+	assert.Nil(t, err)
+	index := string(files["index.html"])
+	assert.Contains(t, index, `<img src="drawings/round-1-drawing-1.png" alt="Drawing by mama cat">`)
+	assert.Contains(t, index, "stinky yummy tuna")
+	assert.Contains(t, index, `big red salmon (decoy by baby cat) <span class="voters">picked by papa cat</span>`)
+	assert.Contains(t, index, "baby cat +1 (FooledPlayer, papa cat)")
+	assert.Contains(t, index, "<li>mama cat: 3 points</li>\n<li>baby cat: 1 points</li>")
+	// Drawings whose image is missing are listed without it
+	assert.Contains(t, index, "Image not available")
+}
+
+func TestWriteGallery_IncludesTimelapses(t *testing.T) {
+	drawingStore := images.NewMemoryDrawingStore()
+	transcript := &archive.Transcript{
+		GameID:    "1",
+		GroupName: "cat party",
+		Rounds: []*archive.Round{{
+			Number: 1,
+			Drawings: []*archive.Drawing{{
+				Author:  "mama cat",
+				ImageID: storedTestImage(t, drawingStore, "image/png"),
+				Strokes: []*archive.Stroke{{
+					Color:  "#000000",
+					Width:  4,
+					Points: []archive.StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 200, Y: 200, Time: 500}},
+				}},
+				OriginalPrompt: &archive.Prompt{Identifier: "1", Noun: "tuna", Adjectives: []string{"stinky", "yummy"}},
+			}},
+		}},
+	}
+	gallery := bytes.Buffer{}
+	err := WriteGallery(transcript, drawingStore, &gallery)
+	assert.Nil(t, err)
+	files := readZip(t, gallery.Bytes())
+	assert.Len(t, files, 3)
+	_, err = gif.DecodeAll(bytes.NewReader(files["drawings/round-1-drawing-1.gif"]))
+	assert.Nil(t, err)
+	assert.Contains(t, string(files["index.html"]), `Drawn by mama cat (<a href="drawings/round-1-drawing-1.gif">watch it being drawn</a>)`)
+}
+
+func TestWriteGallery_UsesStoredTimelapses(t *testing.T) {
+	drawingStore := images.NewMemoryDrawingStore()
+	timelapseID, err := drawingStore.SaveDrawing(&images.StoredDrawing{MediaType: "image/gif", Data: []byte("stored timelapse")})
+	assert.Nil(t, err)
+	transcript := &archive.Transcript{
+		GameID:    "1",
+		GroupName: "cat party",
+		Rounds: []*archive.Round{{
+			Number: 1,
+			Drawings: []*archive.Drawing{{
+				Author:  "mama cat",
+				ImageID: storedTestImage(t, drawingStore, "image/png"),
+				Strokes: []*archive.Stroke{{
+					Color:  "#000000",
+					Width:  4,
+					Points: []archive.StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 200, Y: 200, Time: 500}},
+				}},
+				TimelapseID:    timelapseID,
+				OriginalPrompt: &archive.Prompt{Identifier: "1", Noun: "tuna", Adjectives: []string{"stinky", "yummy"}},
+			}},
+		}},
+	}
+	gallery := bytes.Buffer{}
+	err = WriteGallery(transcript, drawingStore, &gallery)
+	assert.Nil(t, err)
+	files := readZip(t, gallery.Bytes())
+	assert.Equal(t, "stored timelapse", string(files["drawings/round-1-drawing-1.gif"]))
+}
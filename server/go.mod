@@ -5,7 +5,13 @@ go 1.12
 require (
 	github.com/gin-gonic/contrib v0.0.0-20191209060500-d6e26eeaa607
 	github.com/gin-gonic/gin v1.6.3
+	github.com/go-playground/validator/v10 v10.2.0
+	github.com/golang/protobuf v1.5.0
 	github.com/heroku/x v0.0.0-20171004170240-705849e307dd
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	github.com/prometheus/client_golang v1.11.1
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/text v0.3.2
+	google.golang.org/grpc v1.33.2
+	google.golang.org/protobuf v1.26.0
 )
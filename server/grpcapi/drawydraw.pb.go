@@ -0,0 +1,3259 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.5.1
+// source: drawydraw.proto
+
+package grpcapi
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type PlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+}
+
+func (x *PlayerRequest) Reset() {
+	*x = PlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRequest) ProtoMessage() {}
+
+func (x *PlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRequest.ProtoReflect.Descriptor instead.
+func (*PlayerRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PlayerRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+// CreateGroupRequest plays the server's default number of rounds when round_count is 0
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName               string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName              string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	AllowSelfVotes          bool   `protobuf:"varint,3,opt,name=allow_self_votes,json=allowSelfVotes,proto3" json:"allow_self_votes,omitempty"`
+	RoundCount              uint32 `protobuf:"varint,4,opt,name=round_count,json=roundCount,proto3" json:"round_count,omitempty"`
+	DrawingTimeLimitSeconds uint32 `protobuf:"varint,5,opt,name=drawing_time_limit_seconds,json=drawingTimeLimitSeconds,proto3" json:"drawing_time_limit_seconds,omitempty"`
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetAllowSelfVotes() bool {
+	if x != nil {
+		return x.AllowSelfVotes
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetRoundCount() uint32 {
+	if x != nil {
+		return x.RoundCount
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetDrawingTimeLimitSeconds() uint32 {
+	if x != nil {
+		return x.DrawingTimeLimitSeconds
+	}
+	return 0
+}
+
+type AddPromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Noun       string `protobuf:"bytes,3,opt,name=noun,proto3" json:"noun,omitempty"`
+	Adjective1 string `protobuf:"bytes,4,opt,name=adjective1,proto3" json:"adjective1,omitempty"`
+	Adjective2 string `protobuf:"bytes,5,opt,name=adjective2,proto3" json:"adjective2,omitempty"`
+}
+
+func (x *AddPromptRequest) Reset() {
+	*x = AddPromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPromptRequest) ProtoMessage() {}
+
+func (x *AddPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPromptRequest.ProtoReflect.Descriptor instead.
+func (*AddPromptRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddPromptRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AddPromptRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *AddPromptRequest) GetNoun() string {
+	if x != nil {
+		return x.Noun
+	}
+	return ""
+}
+
+func (x *AddPromptRequest) GetAdjective1() string {
+	if x != nil {
+		return x.Adjective1
+	}
+	return ""
+}
+
+func (x *AddPromptRequest) GetAdjective2() string {
+	if x != nil {
+		return x.Adjective2
+	}
+	return ""
+}
+
+// DrawingRequest has a drawing as either an image data URL or the strokes that make it up
+type DrawingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	// Types that are assignable to Drawing:
+	//	*DrawingRequest_ImageData
+	//	*DrawingRequest_Strokes
+	Drawing isDrawingRequest_Drawing `protobuf_oneof:"drawing"`
+}
+
+func (x *DrawingRequest) Reset() {
+	*x = DrawingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawingRequest) ProtoMessage() {}
+
+func (x *DrawingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawingRequest.ProtoReflect.Descriptor instead.
+func (*DrawingRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DrawingRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *DrawingRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (m *DrawingRequest) GetDrawing() isDrawingRequest_Drawing {
+	if m != nil {
+		return m.Drawing
+	}
+	return nil
+}
+
+func (x *DrawingRequest) GetImageData() string {
+	if x, ok := x.GetDrawing().(*DrawingRequest_ImageData); ok {
+		return x.ImageData
+	}
+	return ""
+}
+
+func (x *DrawingRequest) GetStrokes() *Strokes {
+	if x, ok := x.GetDrawing().(*DrawingRequest_Strokes); ok {
+		return x.Strokes
+	}
+	return nil
+}
+
+type isDrawingRequest_Drawing interface {
+	isDrawingRequest_Drawing()
+}
+
+type DrawingRequest_ImageData struct {
+	ImageData string `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3,oneof"`
+}
+
+type DrawingRequest_Strokes struct {
+	Strokes *Strokes `protobuf:"bytes,4,opt,name=strokes,proto3,oneof"`
+}
+
+func (*DrawingRequest_ImageData) isDrawingRequest_Drawing() {}
+
+func (*DrawingRequest_Strokes) isDrawingRequest_Drawing() {}
+
+type Strokes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strokes []*Stroke `protobuf:"bytes,1,rep,name=strokes,proto3" json:"strokes,omitempty"`
+}
+
+func (x *Strokes) Reset() {
+	*x = Strokes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strokes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strokes) ProtoMessage() {}
+
+func (x *Strokes) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strokes.ProtoReflect.Descriptor instead.
+func (*Strokes) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Strokes) GetStrokes() []*Stroke {
+	if x != nil {
+		return x.Strokes
+	}
+	return nil
+}
+
+type CastVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName        string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName       string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	SelectedPromptId string `protobuf:"bytes,3,opt,name=selected_prompt_id,json=selectedPromptId,proto3" json:"selected_prompt_id,omitempty"`
+}
+
+func (x *CastVoteRequest) Reset() {
+	*x = CastVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastVoteRequest) ProtoMessage() {}
+
+func (x *CastVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastVoteRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CastVoteRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CastVoteRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CastVoteRequest) GetSelectedPromptId() string {
+	if x != nil {
+		return x.SelectedPromptId
+	}
+	return ""
+}
+
+type PauseGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameRequest) ProtoMessage() {}
+
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PauseGameRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetLanguageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Language   string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *SetLanguageRequest) Reset() {
+	*x = SetLanguageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLanguageRequest) ProtoMessage() {}
+
+func (x *SetLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLanguageRequest.ProtoReflect.Descriptor instead.
+func (*SetLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetLanguageRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SetLanguageRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SetLanguageRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type GetGameTranscriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameTranscriptRequest) Reset() {
+	*x = GetGameTranscriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameTranscriptRequest) ProtoMessage() {}
+
+func (x *GetGameTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*GetGameTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGameTranscriptRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GetGameTranscriptRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetDrawingImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageId string `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+}
+
+func (x *GetDrawingImageRequest) Reset() {
+	*x = GetDrawingImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDrawingImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrawingImageRequest) ProtoMessage() {}
+
+func (x *GetDrawingImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrawingImageRequest.ProtoReflect.Descriptor instead.
+func (*GetDrawingImageRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDrawingImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+// GetDrawingTimelapseRequest leaves the frame rate and length to the server's options when they're 0
+type GetDrawingTimelapseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName  string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Author     string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	FrameRate  uint32 `protobuf:"varint,3,opt,name=frame_rate,json=frameRate,proto3" json:"frame_rate,omitempty"`
+	MaxSeconds uint32 `protobuf:"varint,4,opt,name=max_seconds,json=maxSeconds,proto3" json:"max_seconds,omitempty"`
+}
+
+func (x *GetDrawingTimelapseRequest) Reset() {
+	*x = GetDrawingTimelapseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDrawingTimelapseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrawingTimelapseRequest) ProtoMessage() {}
+
+func (x *GetDrawingTimelapseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrawingTimelapseRequest.ProtoReflect.Descriptor instead.
+func (*GetDrawingTimelapseRequest) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDrawingTimelapseRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GetDrawingTimelapseRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetDrawingTimelapseRequest) GetFrameRate() uint32 {
+	if x != nil {
+		return x.FrameRate
+	}
+	return 0
+}
+
+func (x *GetDrawingTimelapseRequest) GetMaxSeconds() uint32 {
+	if x != nil {
+		return x.MaxSeconds
+	}
+	return 0
+}
+
+type Image struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType string `protobuf:"bytes,1,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Image) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *Image) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GameStatus is everything about a game that a player can see
+type GameStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPlayer  *CurrentPlayer            `protobuf:"bytes,1,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	CurrentState   string                    `protobuf:"bytes,2,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	GroupName      string                    `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Players        []*Player                 `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	CurrentDrawing *Drawing                  `protobuf:"bytes,5,opt,name=current_drawing,json=currentDrawing,proto3" json:"current_drawing,omitempty"`
+	PointStandings map[string]*PointStanding `protobuf:"bytes,6,rep,name=point_standings,json=pointStandings,proto3" json:"point_standings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PastDrawings   []*Drawing                `protobuf:"bytes,7,rep,name=past_drawings,json=pastDrawings,proto3" json:"past_drawings,omitempty"`
+	Paused         bool                      `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason    string                    `protobuf:"bytes,9,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
+	// drawing_deadline is when drafts get submitted for the players who haven't submitted a drawing
+	DrawingDeadline *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=drawing_deadline,json=drawingDeadline,proto3" json:"drawing_deadline,omitempty"`
+	// bot_turn_at is when the next bot takes its turn, if any bot has one to take
+	BotTurnAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=bot_turn_at,json=botTurnAt,proto3" json:"bot_turn_at,omitempty"`
+}
+
+func (x *GameStatus) Reset() {
+	*x = GameStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStatus) ProtoMessage() {}
+
+func (x *GameStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStatus.ProtoReflect.Descriptor instead.
+func (*GameStatus) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GameStatus) GetCurrentPlayer() *CurrentPlayer {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return nil
+}
+
+func (x *GameStatus) GetCurrentState() string {
+	if x != nil {
+		return x.CurrentState
+	}
+	return ""
+}
+
+func (x *GameStatus) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GameStatus) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameStatus) GetCurrentDrawing() *Drawing {
+	if x != nil {
+		return x.CurrentDrawing
+	}
+	return nil
+}
+
+func (x *GameStatus) GetPointStandings() map[string]*PointStanding {
+	if x != nil {
+		return x.PointStandings
+	}
+	return nil
+}
+
+func (x *GameStatus) GetPastDrawings() []*Drawing {
+	if x != nil {
+		return x.PastDrawings
+	}
+	return nil
+}
+
+func (x *GameStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GameStatus) GetPauseReason() string {
+	if x != nil {
+		return x.PauseReason
+	}
+	return ""
+}
+
+func (x *GameStatus) GetDrawingDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrawingDeadline
+	}
+	return nil
+}
+
+func (x *GameStatus) GetBotTurnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BotTurnAt
+	}
+	return nil
+}
+
+type Prompt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Adjectives []string `protobuf:"bytes,2,rep,name=adjectives,proto3" json:"adjectives,omitempty"`
+	Noun       string   `protobuf:"bytes,3,opt,name=noun,proto3" json:"noun,omitempty"`
+}
+
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prompt) ProtoMessage() {}
+
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Prompt) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *Prompt) GetAdjectives() []string {
+	if x != nil {
+		return x.Adjectives
+	}
+	return nil
+}
+
+func (x *Prompt) GetNoun() string {
+	if x != nil {
+		return x.Noun
+	}
+	return ""
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host             bool   `protobuf:"varint,2,opt,name=host,proto3" json:"host,omitempty"`
+	Points           uint64 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	HasPendingAction bool   `protobuf:"varint,4,opt,name=has_pending_action,json=hasPendingAction,proto3" json:"has_pending_action,omitempty"`
+	Bot              bool   `protobuf:"varint,5,opt,name=bot,proto3" json:"bot,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetHost() bool {
+	if x != nil {
+		return x.Host
+	}
+	return false
+}
+
+func (x *Player) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Player) GetHasPendingAction() bool {
+	if x != nil {
+		return x.HasPendingAction
+	}
+	return false
+}
+
+func (x *Player) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+type CurrentPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignedPrompt     *Prompt  `protobuf:"bytes,1,opt,name=assigned_prompt,json=assignedPrompt,proto3" json:"assigned_prompt,omitempty"`
+	IsHost             bool     `protobuf:"varint,2,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
+	Name               string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	HasCompletedAction bool     `protobuf:"varint,4,opt,name=has_completed_action,json=hasCompletedAction,proto3" json:"has_completed_action,omitempty"`
+	Language           string   `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	DraftDrawing       *Drawing `protobuf:"bytes,6,opt,name=draft_drawing,json=draftDrawing,proto3" json:"draft_drawing,omitempty"`
+}
+
+func (x *CurrentPlayer) Reset() {
+	*x = CurrentPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentPlayer) ProtoMessage() {}
+
+func (x *CurrentPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentPlayer.ProtoReflect.Descriptor instead.
+func (*CurrentPlayer) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CurrentPlayer) GetAssignedPrompt() *Prompt {
+	if x != nil {
+		return x.AssignedPrompt
+	}
+	return nil
+}
+
+func (x *CurrentPlayer) GetIsHost() bool {
+	if x != nil {
+		return x.IsHost
+	}
+	return false
+}
+
+func (x *CurrentPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurrentPlayer) GetHasCompletedAction() bool {
+	if x != nil {
+		return x.HasCompletedAction
+	}
+	return false
+}
+
+func (x *CurrentPlayer) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CurrentPlayer) GetDraftDrawing() *Drawing {
+	if x != nil {
+		return x.DraftDrawing
+	}
+	return nil
+}
+
+// StrokePoint is a point in a stroke along with how many milliseconds into the drawing it was drawn
+type StrokePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X    float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y    float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Time int64   `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StrokePoint) Reset() {
+	*x = StrokePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrokePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrokePoint) ProtoMessage() {}
+
+func (x *StrokePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrokePoint.ProtoReflect.Descriptor instead.
+func (*StrokePoint) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StrokePoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *StrokePoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *StrokePoint) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type Stroke struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color  string         `protobuf:"bytes,1,opt,name=color,proto3" json:"color,omitempty"`
+	Width  float64        `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Points []*StrokePoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *Stroke) Reset() {
+	*x = Stroke{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stroke) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stroke) ProtoMessage() {}
+
+func (x *Stroke) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stroke.ProtoReflect.Descriptor instead.
+func (*Stroke) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Stroke) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Stroke) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Stroke) GetPoints() []*StrokePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type Drawing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author         string    `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	ImageUrl       string    `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ThumbnailUrl   string    `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Strokes        []*Stroke `protobuf:"bytes,4,rep,name=strokes,proto3" json:"strokes,omitempty"`
+	Prompts        []*Prompt `protobuf:"bytes,5,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	OriginalPrompt *Prompt   `protobuf:"bytes,6,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
+}
+
+func (x *Drawing) Reset() {
+	*x = Drawing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Drawing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Drawing) ProtoMessage() {}
+
+func (x *Drawing) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Drawing.ProtoReflect.Descriptor instead.
+func (*Drawing) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Drawing) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Drawing) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Drawing) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Drawing) GetStrokes() []*Stroke {
+	if x != nil {
+		return x.Strokes
+	}
+	return nil
+}
+
+func (x *Drawing) GetPrompts() []*Prompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+func (x *Drawing) GetOriginalPrompt() *Prompt {
+	if x != nil {
+		return x.OriginalPrompt
+	}
+	return nil
+}
+
+type PointsBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount        uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CausingPlayer string `protobuf:"bytes,3,opt,name=causing_player,json=causingPlayer,proto3" json:"causing_player,omitempty"`
+}
+
+func (x *PointsBreakdown) Reset() {
+	*x = PointsBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointsBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointsBreakdown) ProtoMessage() {}
+
+func (x *PointsBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointsBreakdown.ProtoReflect.Descriptor instead.
+func (*PointsBreakdown) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PointsBreakdown) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PointsBreakdown) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PointsBreakdown) GetCausingPlayer() string {
+	if x != nil {
+		return x.CausingPlayer
+	}
+	return ""
+}
+
+type PointStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalScore           uint64             `protobuf:"varint,1,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	Player               string             `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	RoundPointsBreakdown []*PointsBreakdown `protobuf:"bytes,3,rep,name=round_points_breakdown,json=roundPointsBreakdown,proto3" json:"round_points_breakdown,omitempty"`
+}
+
+func (x *PointStanding) Reset() {
+	*x = PointStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointStanding) ProtoMessage() {}
+
+func (x *PointStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointStanding.ProtoReflect.Descriptor instead.
+func (*PointStanding) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PointStanding) GetTotalScore() uint64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *PointStanding) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *PointStanding) GetRoundPointsBreakdown() []*PointsBreakdown {
+	if x != nil {
+		return x.RoundPointsBreakdown
+	}
+	return nil
+}
+
+type GameHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*GameSummary `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *GameHistory) Reset() {
+	*x = GameHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameHistory) ProtoMessage() {}
+
+func (x *GameHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameHistory.ProtoReflect.Descriptor instead.
+func (*GameHistory) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GameHistory) GetGames() []*GameSummary {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GameSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GroupName  string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Players    []*PlayerScore         `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	RoundCount uint32                 `protobuf:"varint,6,opt,name=round_count,json=roundCount,proto3" json:"round_count,omitempty"`
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GameSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GameSummary) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GameSummary) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *GameSummary) GetPlayers() []*PlayerScore {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameSummary) GetRoundCount() uint32 {
+	if x != nil {
+		return x.RoundCount
+	}
+	return 0
+}
+
+type PlayerScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Points uint64 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *PlayerScore) Reset() {
+	*x = PlayerScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerScore) ProtoMessage() {}
+
+func (x *PlayerScore) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerScore.ProtoReflect.Descriptor instead.
+func (*PlayerScore) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlayerScore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerScore) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type Transcript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GroupName  string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Players    []*PlayerScore         `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Rounds     []*Round               `protobuf:"bytes,6,rep,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (x *Transcript) Reset() {
+	*x = Transcript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transcript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transcript) ProtoMessage() {}
+
+func (x *Transcript) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transcript.ProtoReflect.Descriptor instead.
+func (*Transcript) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Transcript) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Transcript) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *Transcript) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Transcript) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Transcript) GetPlayers() []*PlayerScore {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Transcript) GetRounds() []*Round {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type Round struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number   uint32             `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Drawings []*ArchivedDrawing `protobuf:"bytes,2,rep,name=drawings,proto3" json:"drawings,omitempty"`
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Round) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Round) GetDrawings() []*ArchivedDrawing {
+	if x != nil {
+		return x.Drawings
+	}
+	return nil
+}
+
+type ArchivedDrawing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author         string            `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	ImageId        string            `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	ImageUrl       string            `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Strokes        []*Stroke         `protobuf:"bytes,4,rep,name=strokes,proto3" json:"strokes,omitempty"`
+	OriginalPrompt *ArchivedPrompt   `protobuf:"bytes,5,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
+	DecoyPrompts   []*ArchivedPrompt `protobuf:"bytes,6,rep,name=decoy_prompts,json=decoyPrompts,proto3" json:"decoy_prompts,omitempty"`
+	Votes          []*Vote           `protobuf:"bytes,7,rep,name=votes,proto3" json:"votes,omitempty"`
+	Points         []*Points         `protobuf:"bytes,8,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *ArchivedDrawing) Reset() {
+	*x = ArchivedDrawing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedDrawing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedDrawing) ProtoMessage() {}
+
+func (x *ArchivedDrawing) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedDrawing.ProtoReflect.Descriptor instead.
+func (*ArchivedDrawing) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ArchivedDrawing) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ArchivedDrawing) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *ArchivedDrawing) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ArchivedDrawing) GetStrokes() []*Stroke {
+	if x != nil {
+		return x.Strokes
+	}
+	return nil
+}
+
+func (x *ArchivedDrawing) GetOriginalPrompt() *ArchivedPrompt {
+	if x != nil {
+		return x.OriginalPrompt
+	}
+	return nil
+}
+
+func (x *ArchivedDrawing) GetDecoyPrompts() []*ArchivedPrompt {
+	if x != nil {
+		return x.DecoyPrompts
+	}
+	return nil
+}
+
+func (x *ArchivedDrawing) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *ArchivedDrawing) GetPoints() []*Points {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ArchivedPrompt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Author     string   `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Noun       string   `protobuf:"bytes,3,opt,name=noun,proto3" json:"noun,omitempty"`
+	Adjectives []string `protobuf:"bytes,4,rep,name=adjectives,proto3" json:"adjectives,omitempty"`
+}
+
+func (x *ArchivedPrompt) Reset() {
+	*x = ArchivedPrompt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedPrompt) ProtoMessage() {}
+
+func (x *ArchivedPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedPrompt.ProtoReflect.Descriptor instead.
+func (*ArchivedPrompt) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ArchivedPrompt) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ArchivedPrompt) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ArchivedPrompt) GetNoun() string {
+	if x != nil {
+		return x.Noun
+	}
+	return ""
+}
+
+func (x *ArchivedPrompt) GetAdjectives() []string {
+	if x != nil {
+		return x.Adjectives
+	}
+	return nil
+}
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player           string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	PromptIdentifier string `protobuf:"bytes,2,opt,name=prompt_identifier,json=promptIdentifier,proto3" json:"prompt_identifier,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Vote) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *Vote) GetPromptIdentifier() string {
+	if x != nil {
+		return x.PromptIdentifier
+	}
+	return ""
+}
+
+type Points struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Amount        uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CausingPlayer string `protobuf:"bytes,4,opt,name=causing_player,json=causingPlayer,proto3" json:"causing_player,omitempty"`
+}
+
+func (x *Points) Reset() {
+	*x = Points{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Points) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Points) ProtoMessage() {}
+
+func (x *Points) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Points.ProtoReflect.Descriptor instead.
+func (*Points) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Points) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *Points) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Points) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Points) GetCausingPlayer() string {
+	if x != nil {
+		return x.CausingPlayer
+	}
+	return ""
+}
+
+// ErrorDetail is attached to the status of failed calls
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is one of the error codes of the HTTP API, like GAME_NOT_FOUND
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// message is meant for players, in the language they picked
+	Message string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Fields  []*FieldError `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ErrorDetail) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetFields() []*FieldError {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// FieldError describes why a field of a request is invalid
+type FieldError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Rule    string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_drawydraw_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_drawydraw_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_drawydraw_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *FieldError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_drawydraw_proto protoreflect.FileDescriptor
+
+var file_drawydraw_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4f, 0x0a,
+	0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2d,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdc, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65,
+	0x6c, 0x66, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x6c, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x1a, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x17, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x75, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x32, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x72, 0x61,
+	0x77, 0x69, 0x6e, 0x67, 0x22, 0x36, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x0f,
+	0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x22, 0x6a, 0x0a,
+	0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x33, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x77,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3a, 0x0a, 0x05, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x05, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x72,
+	0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x61,
+	0x77, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x74,
+	0x5f, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x44, 0x72, 0x61, 0x77,
+	0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x74, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x10,
+	0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0f, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x6f, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x1a,
+	0x5b, 0x0a, 0x13, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x06,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x75, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x75, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x06, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x62, 0x6f, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x68, 0x61, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x37,
+	0x0a, 0x0d, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x22, 0x3d, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x6f, 0x6b,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xf9, 0x01, 0x0a,
+	0x07, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55,
+	0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e,
+	0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0x68, 0x0a, 0x0f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x61, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x50, 0x0a,
+	0x16, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22,
+	0x3b, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x90, 0x02, 0x0a,
+	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x39, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x0a, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x72,
+	0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79,
+	0x64, 0x72, 0x61, 0x77, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x44, 0x72, 0x61,
+	0x77, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe4,
+	0x02, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x44, 0x72, 0x61, 0x77, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e,
+	0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79,
+	0x64, 0x72, 0x61, 0x77, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x79, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x75, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x22, 0x77, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x75, 0x73,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72,
+	0x61, 0x77, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x50, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xbf, 0x09, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77,
+	0x79, 0x44, 0x72, 0x61, 0x77, 0x12, 0x43, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x41, 0x64,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77,
+	0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x42,
+	0x6f, 0x74, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64,
+	0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x12, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x41, 0x64, 0x64,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x72,
+	0x61, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x64, 0x72,
+	0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72,
+	0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x08, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x72, 0x61, 0x77,
+	0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x09,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x64, 0x72, 0x61, 0x77,
+	0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72,
+	0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x72,
+	0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07,
+	0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72,
+	0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x17, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79,
+	0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61,
+	0x77, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x12, 0x25, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x61, 0x77, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64,
+	0x72, 0x61, 0x77, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72,
+	0x61, 0x77, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x42, 0x13, 0x5a, 0x11, 0x64, 0x72, 0x61,
+	0x77, 0x79, 0x64, 0x72, 0x61, 0x77, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_drawydraw_proto_rawDescOnce sync.Once
+	file_drawydraw_proto_rawDescData = file_drawydraw_proto_rawDesc
+)
+
+func file_drawydraw_proto_rawDescGZIP() []byte {
+	file_drawydraw_proto_rawDescOnce.Do(func() {
+		file_drawydraw_proto_rawDescData = protoimpl.X.CompressGZIP(file_drawydraw_proto_rawDescData)
+	})
+	return file_drawydraw_proto_rawDescData
+}
+
+var file_drawydraw_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_drawydraw_proto_goTypes = []interface{}{
+	(*PlayerRequest)(nil),              // 0: drawydraw.PlayerRequest
+	(*GroupRequest)(nil),               // 1: drawydraw.GroupRequest
+	(*CreateGroupRequest)(nil),         // 2: drawydraw.CreateGroupRequest
+	(*AddPromptRequest)(nil),           // 3: drawydraw.AddPromptRequest
+	(*DrawingRequest)(nil),             // 4: drawydraw.DrawingRequest
+	(*Strokes)(nil),                    // 5: drawydraw.Strokes
+	(*CastVoteRequest)(nil),            // 6: drawydraw.CastVoteRequest
+	(*PauseGameRequest)(nil),           // 7: drawydraw.PauseGameRequest
+	(*SetLanguageRequest)(nil),         // 8: drawydraw.SetLanguageRequest
+	(*GetGameTranscriptRequest)(nil),   // 9: drawydraw.GetGameTranscriptRequest
+	(*GetDrawingImageRequest)(nil),     // 10: drawydraw.GetDrawingImageRequest
+	(*GetDrawingTimelapseRequest)(nil), // 11: drawydraw.GetDrawingTimelapseRequest
+	(*Image)(nil),                      // 12: drawydraw.Image
+	(*GameStatus)(nil),                 // 13: drawydraw.GameStatus
+	(*Prompt)(nil),                     // 14: drawydraw.Prompt
+	(*Player)(nil),                     // 15: drawydraw.Player
+	(*CurrentPlayer)(nil),              // 16: drawydraw.CurrentPlayer
+	(*StrokePoint)(nil),                // 17: drawydraw.StrokePoint
+	(*Stroke)(nil),                     // 18: drawydraw.Stroke
+	(*Drawing)(nil),                    // 19: drawydraw.Drawing
+	(*PointsBreakdown)(nil),            // 20: drawydraw.PointsBreakdown
+	(*PointStanding)(nil),              // 21: drawydraw.PointStanding
+	(*GameHistory)(nil),                // 22: drawydraw.GameHistory
+	(*GameSummary)(nil),                // 23: drawydraw.GameSummary
+	(*PlayerScore)(nil),                // 24: drawydraw.PlayerScore
+	(*Transcript)(nil),                 // 25: drawydraw.Transcript
+	(*Round)(nil),                      // 26: drawydraw.Round
+	(*ArchivedDrawing)(nil),            // 27: drawydraw.ArchivedDrawing
+	(*ArchivedPrompt)(nil),             // 28: drawydraw.ArchivedPrompt
+	(*Vote)(nil),                       // 29: drawydraw.Vote
+	(*Points)(nil),                     // 30: drawydraw.Points
+	(*ErrorDetail)(nil),                // 31: drawydraw.ErrorDetail
+	(*FieldError)(nil),                 // 32: drawydraw.FieldError
+	nil,                                // 33: drawydraw.GameStatus.PointStandingsEntry
+	(*timestamppb.Timestamp)(nil),      // 34: google.protobuf.Timestamp
+}
+var file_drawydraw_proto_depIdxs = []int32{
+	5,  // 0: drawydraw.DrawingRequest.strokes:type_name -> drawydraw.Strokes
+	18, // 1: drawydraw.Strokes.strokes:type_name -> drawydraw.Stroke
+	16, // 2: drawydraw.GameStatus.current_player:type_name -> drawydraw.CurrentPlayer
+	15, // 3: drawydraw.GameStatus.players:type_name -> drawydraw.Player
+	19, // 4: drawydraw.GameStatus.current_drawing:type_name -> drawydraw.Drawing
+	33, // 5: drawydraw.GameStatus.point_standings:type_name -> drawydraw.GameStatus.PointStandingsEntry
+	19, // 6: drawydraw.GameStatus.past_drawings:type_name -> drawydraw.Drawing
+	34, // 7: drawydraw.GameStatus.drawing_deadline:type_name -> google.protobuf.Timestamp
+	34, // 8: drawydraw.GameStatus.bot_turn_at:type_name -> google.protobuf.Timestamp
+	14, // 9: drawydraw.CurrentPlayer.assigned_prompt:type_name -> drawydraw.Prompt
+	19, // 10: drawydraw.CurrentPlayer.draft_drawing:type_name -> drawydraw.Drawing
+	17, // 11: drawydraw.Stroke.points:type_name -> drawydraw.StrokePoint
+	18, // 12: drawydraw.Drawing.strokes:type_name -> drawydraw.Stroke
+	14, // 13: drawydraw.Drawing.prompts:type_name -> drawydraw.Prompt
+	14, // 14: drawydraw.Drawing.original_prompt:type_name -> drawydraw.Prompt
+	20, // 15: drawydraw.PointStanding.round_points_breakdown:type_name -> drawydraw.PointsBreakdown
+	23, // 16: drawydraw.GameHistory.games:type_name -> drawydraw.GameSummary
+	34, // 17: drawydraw.GameSummary.started_at:type_name -> google.protobuf.Timestamp
+	34, // 18: drawydraw.GameSummary.finished_at:type_name -> google.protobuf.Timestamp
+	24, // 19: drawydraw.GameSummary.players:type_name -> drawydraw.PlayerScore
+	34, // 20: drawydraw.Transcript.started_at:type_name -> google.protobuf.Timestamp
+	34, // 21: drawydraw.Transcript.finished_at:type_name -> google.protobuf.Timestamp
+	24, // 22: drawydraw.Transcript.players:type_name -> drawydraw.PlayerScore
+	26, // 23: drawydraw.Transcript.rounds:type_name -> drawydraw.Round
+	27, // 24: drawydraw.Round.drawings:type_name -> drawydraw.ArchivedDrawing
+	18, // 25: drawydraw.ArchivedDrawing.strokes:type_name -> drawydraw.Stroke
+	28, // 26: drawydraw.ArchivedDrawing.original_prompt:type_name -> drawydraw.ArchivedPrompt
+	28, // 27: drawydraw.ArchivedDrawing.decoy_prompts:type_name -> drawydraw.ArchivedPrompt
+	29, // 28: drawydraw.ArchivedDrawing.votes:type_name -> drawydraw.Vote
+	30, // 29: drawydraw.ArchivedDrawing.points:type_name -> drawydraw.Points
+	32, // 30: drawydraw.ErrorDetail.fields:type_name -> drawydraw.FieldError
+	21, // 31: drawydraw.GameStatus.PointStandingsEntry.value:type_name -> drawydraw.PointStanding
+	2,  // 32: drawydraw.DrawyDraw.CreateGroup:input_type -> drawydraw.CreateGroupRequest
+	0,  // 33: drawydraw.DrawyDraw.AddPlayer:input_type -> drawydraw.PlayerRequest
+	0,  // 34: drawydraw.DrawyDraw.GetGameStatus:input_type -> drawydraw.PlayerRequest
+	0,  // 35: drawydraw.DrawyDraw.StartGame:input_type -> drawydraw.PlayerRequest
+	0,  // 36: drawydraw.DrawyDraw.AddBot:input_type -> drawydraw.PlayerRequest
+	3,  // 37: drawydraw.DrawyDraw.AddPrompt:input_type -> drawydraw.AddPromptRequest
+	4,  // 38: drawydraw.DrawyDraw.SubmitDrawing:input_type -> drawydraw.DrawingRequest
+	4,  // 39: drawydraw.DrawyDraw.SaveDraftDrawing:input_type -> drawydraw.DrawingRequest
+	6,  // 40: drawydraw.DrawyDraw.CastVote:input_type -> drawydraw.CastVoteRequest
+	7,  // 41: drawydraw.DrawyDraw.PauseGame:input_type -> drawydraw.PauseGameRequest
+	0,  // 42: drawydraw.DrawyDraw.ResumeGame:input_type -> drawydraw.PlayerRequest
+	0,  // 43: drawydraw.DrawyDraw.Rematch:input_type -> drawydraw.PlayerRequest
+	8,  // 44: drawydraw.DrawyDraw.SetLanguage:input_type -> drawydraw.SetLanguageRequest
+	1,  // 45: drawydraw.DrawyDraw.GetGameHistory:input_type -> drawydraw.GroupRequest
+	9,  // 46: drawydraw.DrawyDraw.GetGameTranscript:input_type -> drawydraw.GetGameTranscriptRequest
+	10, // 47: drawydraw.DrawyDraw.GetDrawingImage:input_type -> drawydraw.GetDrawingImageRequest
+	11, // 48: drawydraw.DrawyDraw.GetDrawingTimelapse:input_type -> drawydraw.GetDrawingTimelapseRequest
+	0,  // 49: drawydraw.DrawyDraw.WatchGame:input_type -> drawydraw.PlayerRequest
+	13, // 50: drawydraw.DrawyDraw.CreateGroup:output_type -> drawydraw.GameStatus
+	13, // 51: drawydraw.DrawyDraw.AddPlayer:output_type -> drawydraw.GameStatus
+	13, // 52: drawydraw.DrawyDraw.GetGameStatus:output_type -> drawydraw.GameStatus
+	13, // 53: drawydraw.DrawyDraw.StartGame:output_type -> drawydraw.GameStatus
+	13, // 54: drawydraw.DrawyDraw.AddBot:output_type -> drawydraw.GameStatus
+	13, // 55: drawydraw.DrawyDraw.AddPrompt:output_type -> drawydraw.GameStatus
+	13, // 56: drawydraw.DrawyDraw.SubmitDrawing:output_type -> drawydraw.GameStatus
+	13, // 57: drawydraw.DrawyDraw.SaveDraftDrawing:output_type -> drawydraw.GameStatus
+	13, // 58: drawydraw.DrawyDraw.CastVote:output_type -> drawydraw.GameStatus
+	13, // 59: drawydraw.DrawyDraw.PauseGame:output_type -> drawydraw.GameStatus
+	13, // 60: drawydraw.DrawyDraw.ResumeGame:output_type -> drawydraw.GameStatus
+	13, // 61: drawydraw.DrawyDraw.Rematch:output_type -> drawydraw.GameStatus
+	13, // 62: drawydraw.DrawyDraw.SetLanguage:output_type -> drawydraw.GameStatus
+	22, // 63: drawydraw.DrawyDraw.GetGameHistory:output_type -> drawydraw.GameHistory
+	25, // 64: drawydraw.DrawyDraw.GetGameTranscript:output_type -> drawydraw.Transcript
+	12, // 65: drawydraw.DrawyDraw.GetDrawingImage:output_type -> drawydraw.Image
+	12, // 66: drawydraw.DrawyDraw.GetDrawingTimelapse:output_type -> drawydraw.Image
+	13, // 67: drawydraw.DrawyDraw.WatchGame:output_type -> drawydraw.GameStatus
+	50, // [50:68] is the sub-list for method output_type
+	32, // [32:50] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_drawydraw_proto_init() }
+func file_drawydraw_proto_init() {
+	if File_drawydraw_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_drawydraw_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strokes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLanguageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameTranscriptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDrawingImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDrawingTimelapseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Image); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prompt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrokePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stroke); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Drawing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointsBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointStanding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transcript); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Round); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedDrawing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedPrompt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Points); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_drawydraw_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_drawydraw_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*DrawingRequest_ImageData)(nil),
+		(*DrawingRequest_Strokes)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_drawydraw_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_drawydraw_proto_goTypes,
+		DependencyIndexes: file_drawydraw_proto_depIdxs,
+		MessageInfos:      file_drawydraw_proto_msgTypes,
+	}.Build()
+	File_drawydraw_proto = out.File
+	file_drawydraw_proto_rawDesc = nil
+	file_drawydraw_proto_goTypes = nil
+	file_drawydraw_proto_depIdxs = nil
+}
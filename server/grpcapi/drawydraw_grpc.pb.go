@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// DrawyDrawClient is the client API for DrawyDraw service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DrawyDrawClient interface {
+	// CreateGroup creates a group and adds the player making the request as its host
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	AddPlayer(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	GetGameStatus(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	StartGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	// AddBot lets the host add a bot player while waiting for players
+	AddBot(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	// AddPrompt adds a prompt, or a decoy prompt while voting is being set up
+	AddPrompt(ctx context.Context, in *AddPromptRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	SubmitDrawing(ctx context.Context, in *DrawingRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	// SaveDraftDrawing saves a drawing in progress, it's submitted when the drawing time runs out
+	SaveDraftDrawing(ctx context.Context, in *DrawingRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	ResumeGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	Rematch(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	SetLanguage(ctx context.Context, in *SetLanguageRequest, opts ...grpc.CallOption) (*GameStatus, error)
+	GetGameHistory(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GameHistory, error)
+	GetGameTranscript(ctx context.Context, in *GetGameTranscriptRequest, opts ...grpc.CallOption) (*Transcript, error)
+	GetDrawingImage(ctx context.Context, in *GetDrawingImageRequest, opts ...grpc.CallOption) (*Image, error)
+	GetDrawingTimelapse(ctx context.Context, in *GetDrawingTimelapseRequest, opts ...grpc.CallOption) (*Image, error)
+	// WatchGame sends the status of the game for a player right away and again every time the game changes
+	WatchGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (DrawyDraw_WatchGameClient, error)
+}
+
+type drawyDrawClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDrawyDrawClient(cc grpc.ClientConnInterface) DrawyDrawClient {
+	return &drawyDrawClient{cc}
+}
+
+func (c *drawyDrawClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) AddPlayer(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/AddPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) GetGameStatus(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/GetGameStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) StartGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/StartGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) AddBot(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/AddBot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) AddPrompt(ctx context.Context, in *AddPromptRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/AddPrompt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) SubmitDrawing(ctx context.Context, in *DrawingRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/SubmitDrawing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) SaveDraftDrawing(ctx context.Context, in *DrawingRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/SaveDraftDrawing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/CastVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/PauseGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) ResumeGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/ResumeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) Rematch(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/Rematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) SetLanguage(ctx context.Context, in *SetLanguageRequest, opts ...grpc.CallOption) (*GameStatus, error) {
+	out := new(GameStatus)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/SetLanguage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) GetGameHistory(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GameHistory, error) {
+	out := new(GameHistory)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/GetGameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) GetGameTranscript(ctx context.Context, in *GetGameTranscriptRequest, opts ...grpc.CallOption) (*Transcript, error) {
+	out := new(Transcript)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/GetGameTranscript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) GetDrawingImage(ctx context.Context, in *GetDrawingImageRequest, opts ...grpc.CallOption) (*Image, error) {
+	out := new(Image)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/GetDrawingImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) GetDrawingTimelapse(ctx context.Context, in *GetDrawingTimelapseRequest, opts ...grpc.CallOption) (*Image, error) {
+	out := new(Image)
+	err := c.cc.Invoke(ctx, "/drawydraw.DrawyDraw/GetDrawingTimelapse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drawyDrawClient) WatchGame(ctx context.Context, in *PlayerRequest, opts ...grpc.CallOption) (DrawyDraw_WatchGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DrawyDraw_serviceDesc.Streams[0], "/drawydraw.DrawyDraw/WatchGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &drawyDrawWatchGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DrawyDraw_WatchGameClient interface {
+	Recv() (*GameStatus, error)
+	grpc.ClientStream
+}
+
+type drawyDrawWatchGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *drawyDrawWatchGameClient) Recv() (*GameStatus, error) {
+	m := new(GameStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DrawyDrawServer is the server API for DrawyDraw service.
+// All implementations must embed UnimplementedDrawyDrawServer
+// for forward compatibility
+type DrawyDrawServer interface {
+	// CreateGroup creates a group and adds the player making the request as its host
+	CreateGroup(context.Context, *CreateGroupRequest) (*GameStatus, error)
+	AddPlayer(context.Context, *PlayerRequest) (*GameStatus, error)
+	GetGameStatus(context.Context, *PlayerRequest) (*GameStatus, error)
+	StartGame(context.Context, *PlayerRequest) (*GameStatus, error)
+	// AddBot lets the host add a bot player while waiting for players
+	AddBot(context.Context, *PlayerRequest) (*GameStatus, error)
+	// AddPrompt adds a prompt, or a decoy prompt while voting is being set up
+	AddPrompt(context.Context, *AddPromptRequest) (*GameStatus, error)
+	SubmitDrawing(context.Context, *DrawingRequest) (*GameStatus, error)
+	// SaveDraftDrawing saves a drawing in progress, it's submitted when the drawing time runs out
+	SaveDraftDrawing(context.Context, *DrawingRequest) (*GameStatus, error)
+	CastVote(context.Context, *CastVoteRequest) (*GameStatus, error)
+	PauseGame(context.Context, *PauseGameRequest) (*GameStatus, error)
+	ResumeGame(context.Context, *PlayerRequest) (*GameStatus, error)
+	Rematch(context.Context, *PlayerRequest) (*GameStatus, error)
+	SetLanguage(context.Context, *SetLanguageRequest) (*GameStatus, error)
+	GetGameHistory(context.Context, *GroupRequest) (*GameHistory, error)
+	GetGameTranscript(context.Context, *GetGameTranscriptRequest) (*Transcript, error)
+	GetDrawingImage(context.Context, *GetDrawingImageRequest) (*Image, error)
+	GetDrawingTimelapse(context.Context, *GetDrawingTimelapseRequest) (*Image, error)
+	// WatchGame sends the status of the game for a player right away and again every time the game changes
+	WatchGame(*PlayerRequest, DrawyDraw_WatchGameServer) error
+	mustEmbedUnimplementedDrawyDrawServer()
+}
+
+// UnimplementedDrawyDrawServer must be embedded to have forward compatible implementations.
+type UnimplementedDrawyDrawServer struct {
+}
+
+func (UnimplementedDrawyDrawServer) CreateGroup(context.Context, *CreateGroupRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedDrawyDrawServer) AddPlayer(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlayer not implemented")
+}
+func (UnimplementedDrawyDrawServer) GetGameStatus(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameStatus not implemented")
+}
+func (UnimplementedDrawyDrawServer) StartGame(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedDrawyDrawServer) AddBot(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBot not implemented")
+}
+func (UnimplementedDrawyDrawServer) AddPrompt(context.Context, *AddPromptRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPrompt not implemented")
+}
+func (UnimplementedDrawyDrawServer) SubmitDrawing(context.Context, *DrawingRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDrawing not implemented")
+}
+func (UnimplementedDrawyDrawServer) SaveDraftDrawing(context.Context, *DrawingRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraftDrawing not implemented")
+}
+func (UnimplementedDrawyDrawServer) CastVote(context.Context, *CastVoteRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
+}
+func (UnimplementedDrawyDrawServer) PauseGame(context.Context, *PauseGameRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (UnimplementedDrawyDrawServer) ResumeGame(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
+}
+func (UnimplementedDrawyDrawServer) Rematch(context.Context, *PlayerRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rematch not implemented")
+}
+func (UnimplementedDrawyDrawServer) SetLanguage(context.Context, *SetLanguageRequest) (*GameStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLanguage not implemented")
+}
+func (UnimplementedDrawyDrawServer) GetGameHistory(context.Context, *GroupRequest) (*GameHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameHistory not implemented")
+}
+func (UnimplementedDrawyDrawServer) GetGameTranscript(context.Context, *GetGameTranscriptRequest) (*Transcript, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameTranscript not implemented")
+}
+func (UnimplementedDrawyDrawServer) GetDrawingImage(context.Context, *GetDrawingImageRequest) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrawingImage not implemented")
+}
+func (UnimplementedDrawyDrawServer) GetDrawingTimelapse(context.Context, *GetDrawingTimelapseRequest) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrawingTimelapse not implemented")
+}
+func (UnimplementedDrawyDrawServer) WatchGame(*PlayerRequest, DrawyDraw_WatchGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedDrawyDrawServer) mustEmbedUnimplementedDrawyDrawServer() {}
+
+// UnsafeDrawyDrawServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DrawyDrawServer will
+// result in compilation errors.
+type UnsafeDrawyDrawServer interface {
+	mustEmbedUnimplementedDrawyDrawServer()
+}
+
+func RegisterDrawyDrawServer(s grpc.ServiceRegistrar, srv DrawyDrawServer) {
+	s.RegisterService(&_DrawyDraw_serviceDesc, srv)
+}
+
+func _DrawyDraw_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_AddPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).AddPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/AddPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).AddPlayer(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_GetGameStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).GetGameStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/GetGameStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).GetGameStatus(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/StartGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).StartGame(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_AddBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).AddBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/AddBot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).AddBot(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_AddPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).AddPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/AddPrompt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).AddPrompt(ctx, req.(*AddPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_SubmitDrawing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).SubmitDrawing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/SubmitDrawing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).SubmitDrawing(ctx, req.(*DrawingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_SaveDraftDrawing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).SaveDraftDrawing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/SaveDraftDrawing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).SaveDraftDrawing(ctx, req.(*DrawingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).CastVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/CastVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).CastVote(ctx, req.(*CastVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/PauseGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).PauseGame(ctx, req.(*PauseGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/ResumeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).ResumeGame(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_Rematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).Rematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/Rematch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).Rematch(ctx, req.(*PlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_SetLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).SetLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/SetLanguage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).SetLanguage(ctx, req.(*SetLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_GetGameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).GetGameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/GetGameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).GetGameHistory(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_GetGameTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).GetGameTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/GetGameTranscript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).GetGameTranscript(ctx, req.(*GetGameTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_GetDrawingImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawingImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).GetDrawingImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/GetDrawingImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).GetDrawingImage(ctx, req.(*GetDrawingImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_GetDrawingTimelapse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawingTimelapseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrawyDrawServer).GetDrawingTimelapse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/drawydraw.DrawyDraw/GetDrawingTimelapse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrawyDrawServer).GetDrawingTimelapse(ctx, req.(*GetDrawingTimelapseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrawyDraw_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlayerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DrawyDrawServer).WatchGame(m, &drawyDrawWatchGameServer{stream})
+}
+
+type DrawyDraw_WatchGameServer interface {
+	Send(*GameStatus) error
+	grpc.ServerStream
+}
+
+type drawyDrawWatchGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *drawyDrawWatchGameServer) Send(m *GameStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DrawyDraw_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "drawydraw.DrawyDraw",
+	HandlerType: (*DrawyDrawServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _DrawyDraw_CreateGroup_Handler,
+		},
+		{
+			MethodName: "AddPlayer",
+			Handler:    _DrawyDraw_AddPlayer_Handler,
+		},
+		{
+			MethodName: "GetGameStatus",
+			Handler:    _DrawyDraw_GetGameStatus_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _DrawyDraw_StartGame_Handler,
+		},
+		{
+			MethodName: "AddBot",
+			Handler:    _DrawyDraw_AddBot_Handler,
+		},
+		{
+			MethodName: "AddPrompt",
+			Handler:    _DrawyDraw_AddPrompt_Handler,
+		},
+		{
+			MethodName: "SubmitDrawing",
+			Handler:    _DrawyDraw_SubmitDrawing_Handler,
+		},
+		{
+			MethodName: "SaveDraftDrawing",
+			Handler:    _DrawyDraw_SaveDraftDrawing_Handler,
+		},
+		{
+			MethodName: "CastVote",
+			Handler:    _DrawyDraw_CastVote_Handler,
+		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _DrawyDraw_PauseGame_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _DrawyDraw_ResumeGame_Handler,
+		},
+		{
+			MethodName: "Rematch",
+			Handler:    _DrawyDraw_Rematch_Handler,
+		},
+		{
+			MethodName: "SetLanguage",
+			Handler:    _DrawyDraw_SetLanguage_Handler,
+		},
+		{
+			MethodName: "GetGameHistory",
+			Handler:    _DrawyDraw_GetGameHistory_Handler,
+		},
+		{
+			MethodName: "GetGameTranscript",
+			Handler:    _DrawyDraw_GetGameTranscript_Handler,
+		},
+		{
+			MethodName: "GetDrawingImage",
+			Handler:    _DrawyDraw_GetDrawingImage_Handler,
+		},
+		{
+			MethodName: "GetDrawingTimelapse",
+			Handler:    _DrawyDraw_GetDrawingTimelapse_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _DrawyDraw_WatchGame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "drawydraw.proto",
+}
@@ -0,0 +1,4 @@
+package grpcapi
+
+// Regenerate the messages and service stubs after changing drawydraw.proto, this needs protoc with protoc-gen-go v1.25 and protoc-gen-go-grpc v1.0
+//go:generate protoc --go_out=paths=source_relative:. --go-grpc_out=paths=source_relative:. drawydraw.proto
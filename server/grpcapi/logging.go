@@ -0,0 +1,72 @@
+package grpcapi
+
+import (
+	"context"
+	"drawydraw/logging"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIDMetadataKey = "x-request-id"
+
+// logUnaryCalls gives every call a request ID like the HTTP API does, sends it back in the x-request-id header and logs the call
+func logUnaryCalls(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	ctx = withRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, logging.RequestID(ctx)))
+	response, err := handler(ctx, request)
+	logCall(ctx, info.FullMethod, start, err)
+	return response, err
+}
+
+// logStreamCalls does what logUnaryCalls does for streams, which are logged when they end
+func logStreamCalls(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx := withRequestID(stream.Context())
+	stream.SetHeader(metadata.Pairs(requestIDMetadataKey, logging.RequestID(ctx)))
+	err := handler(server, &requestStream{ServerStream: stream, ctx: ctx})
+	logCall(ctx, info.FullMethod, start, err)
+	return err
+}
+
+// requestStream is a stream whose context has the call's request ID
+type requestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *requestStream) Context() context.Context {
+	return stream.ctx
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	sentRequestID := ""
+	if incoming, found := metadata.FromIncomingContext(ctx); found {
+		if values := incoming.Get(requestIDMetadataKey); len(values) > 0 {
+			sentRequestID = values[0]
+		}
+	}
+	return logging.WithRequestID(ctx, logging.RequestIDFrom(sentRequestID))
+}
+
+// Successful reads are logged at the debug level since clients poll the game status
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := logging.LevelInfo
+	switch {
+	case code == codes.Internal || code == codes.Unknown:
+		level = logging.LevelError
+	case err == nil && strings.Contains(method, "/Get"):
+		level = logging.LevelDebug
+	}
+	logging.FromContext(ctx).Log(level, "gRPC call", logging.Fields{
+		"method":     method,
+		"code":       code.String(),
+		"durationMs": float64(time.Since(start).Microseconds()) / 1000,
+	})
+}
@@ -0,0 +1,203 @@
+package grpcapi
+
+import (
+	"drawydraw/archive"
+	"drawydraw/statemanager"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Conversions between the statemanager's responses and the messages they're sent as
+
+func gameStatusMessage(gameStatus *statemanager.GameStatusResponse) *GameStatus {
+	message := &GameStatus{
+		CurrentPlayer:   currentPlayerMessage(gameStatus.CurrentPlayer),
+		CurrentState:    gameStatus.CurrentState,
+		GroupName:       gameStatus.GroupName,
+		CurrentDrawing:  drawingMessage(gameStatus.CurrentDrawing),
+		Paused:          gameStatus.Paused,
+		PauseReason:     gameStatus.PauseReason,
+		DrawingDeadline: timestampMessage(gameStatus.DrawingDeadline),
+		BotTurnAt:       timestampMessage(gameStatus.BotTurnAt),
+	}
+	for _, player := range gameStatus.Players {
+		message.Players = append(message.Players, &Player{
+			Name:             player.Name,
+			Host:             player.Host,
+			Points:           player.Points,
+			HasPendingAction: player.HasPendingAction,
+			Bot:              player.Bot,
+		})
+	}
+	if gameStatus.PointStandings != nil {
+		message.PointStandings = map[string]*PointStanding{}
+		for name, standing := range *gameStatus.PointStandings {
+			message.PointStandings[name] = pointStandingMessage(standing)
+		}
+	}
+	for _, drawing := range gameStatus.PastDrawings {
+		message.PastDrawings = append(message.PastDrawings, drawingMessage(drawing))
+	}
+	return message
+}
+
+func currentPlayerMessage(player *statemanager.CurrentPlayer) *CurrentPlayer {
+	if player == nil {
+		return nil
+	}
+	return &CurrentPlayer{
+		AssignedPrompt:     promptMessage(player.AssignedPrompt),
+		IsHost:             player.IsHost,
+		Name:               player.Name,
+		HasCompletedAction: player.HasCompletedAction,
+		Language:           player.Language,
+		DraftDrawing:       drawingMessage(player.DraftDrawing),
+	}
+}
+
+func promptMessage(prompt *statemanager.Prompt) *Prompt {
+	if prompt == nil {
+		return nil
+	}
+	return &Prompt{Identifier: prompt.Identifier, Adjectives: prompt.Adjectives, Noun: prompt.Noun}
+}
+
+func drawingMessage(drawing *statemanager.Drawing) *Drawing {
+	if drawing == nil {
+		return nil
+	}
+	message := &Drawing{
+		Author:         drawing.Author,
+		ImageUrl:       drawing.ImageURL,
+		ThumbnailUrl:   drawing.ThumbnailURL,
+		Strokes:        strokeMessages(drawing.Strokes),
+		OriginalPrompt: promptMessage(drawing.OriginalPrompt),
+	}
+	for _, prompt := range drawing.Prompts {
+		message.Prompts = append(message.Prompts, promptMessage(prompt))
+	}
+	return message
+}
+
+func strokeMessages(strokes []*statemanager.Stroke) []*Stroke {
+	messages := []*Stroke{}
+	for _, stroke := range strokes {
+		message := &Stroke{Color: stroke.Color, Width: stroke.Width}
+		for _, point := range stroke.Points {
+			message.Points = append(message.Points, &StrokePoint{X: point.X, Y: point.Y, Time: point.Time})
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func strokesFromMessages(messages []*Stroke) []*statemanager.Stroke {
+	strokes := []*statemanager.Stroke{}
+	for _, message := range messages {
+		stroke := &statemanager.Stroke{Color: message.Color, Width: message.Width, Points: []statemanager.StrokePoint{}}
+		for _, point := range message.Points {
+			stroke.Points = append(stroke.Points, statemanager.StrokePoint{X: point.X, Y: point.Y, Time: point.Time})
+		}
+		strokes = append(strokes, stroke)
+	}
+	return strokes
+}
+
+func pointStandingMessage(standing *statemanager.PointStanding) *PointStanding {
+	message := &PointStanding{TotalScore: standing.TotalScore, Player: standing.Player}
+	for _, breakdown := range standing.RoundPointsBreakdown {
+		message.RoundPointsBreakdown = append(message.RoundPointsBreakdown, &PointsBreakdown{
+			Amount:        breakdown.Amount,
+			Reason:        string(breakdown.Reason),
+			CausingPlayer: breakdown.CausingPlayer,
+		})
+	}
+	return message
+}
+
+func timestampMessage(timestamp *time.Time) *timestamppb.Timestamp {
+	if timestamp == nil {
+		return nil
+	}
+	return timestamppb.New(*timestamp)
+}
+
+func gameHistoryMessage(summaries []*archive.Summary) *GameHistory {
+	message := &GameHistory{}
+	for _, summary := range summaries {
+		message.Games = append(message.Games, &GameSummary{
+			GameId:     summary.GameID,
+			GroupName:  summary.GroupName,
+			StartedAt:  timestamppb.New(summary.StartedAt),
+			FinishedAt: timestampMessage(summary.FinishedAt),
+			Players:    playerScoreMessages(summary.Players),
+			RoundCount: uint32(summary.RoundCount),
+		})
+	}
+	return message
+}
+
+func playerScoreMessages(scores []*archive.PlayerScore) []*PlayerScore {
+	messages := []*PlayerScore{}
+	for _, score := range scores {
+		messages = append(messages, &PlayerScore{Name: score.Name, Points: score.Points})
+	}
+	return messages
+}
+
+func transcriptMessage(transcript *archive.Transcript) *Transcript {
+	message := &Transcript{
+		GameId:     transcript.GameID,
+		GroupName:  transcript.GroupName,
+		StartedAt:  timestamppb.New(transcript.StartedAt),
+		FinishedAt: timestampMessage(transcript.FinishedAt),
+		Players:    playerScoreMessages(transcript.Players),
+	}
+	for _, round := range transcript.Rounds {
+		roundMessage := &Round{Number: uint32(round.Number)}
+		for _, drawing := range round.Drawings {
+			roundMessage.Drawings = append(roundMessage.Drawings, archivedDrawingMessage(drawing))
+		}
+		message.Rounds = append(message.Rounds, roundMessage)
+	}
+	return message
+}
+
+func archivedDrawingMessage(drawing *archive.Drawing) *ArchivedDrawing {
+	message := &ArchivedDrawing{
+		Author:         drawing.Author,
+		ImageId:        drawing.ImageID,
+		ImageUrl:       drawing.ImageURL,
+		OriginalPrompt: archivedPromptMessage(drawing.OriginalPrompt),
+	}
+	for _, stroke := range drawing.Strokes {
+		strokeMessage := &Stroke{Color: stroke.Color, Width: stroke.Width}
+		for _, point := range stroke.Points {
+			strokeMessage.Points = append(strokeMessage.Points, &StrokePoint{X: point.X, Y: point.Y, Time: point.Time})
+		}
+		message.Strokes = append(message.Strokes, strokeMessage)
+	}
+	for _, prompt := range drawing.DecoyPrompts {
+		message.DecoyPrompts = append(message.DecoyPrompts, archivedPromptMessage(prompt))
+	}
+	for _, vote := range drawing.Votes {
+		message.Votes = append(message.Votes, &Vote{Player: vote.Player, PromptIdentifier: vote.PromptIdentifier})
+	}
+	for _, points := range drawing.Points {
+		message.Points = append(message.Points, &Points{
+			Player:        points.Player,
+			Amount:        points.Amount,
+			Reason:        points.Reason,
+			CausingPlayer: points.CausingPlayer,
+		})
+	}
+	return message
+}
+
+func archivedPromptMessage(prompt *archive.Prompt) *ArchivedPrompt {
+	if prompt == nil {
+		return nil
+	}
+	return &ArchivedPrompt{Identifier: prompt.Identifier, Author: prompt.Author, Noun: prompt.Noun, Adjectives: prompt.Adjectives}
+}
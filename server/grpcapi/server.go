@@ -0,0 +1,465 @@
+package grpcapi
+
+import (
+	"context"
+	"drawydraw/images"
+	"drawydraw/localization"
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"drawydraw/validation"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Server is a gRPC server with the DrawyDraw service registered on it
+type Server struct {
+	*grpc.Server
+	service *service
+}
+
+// NewServer creates a gRPC server with the DrawyDraw service registered on it
+func NewServer() *Server {
+	server := &Server{
+		Server:  grpc.NewServer(grpc.UnaryInterceptor(logUnaryCalls), grpc.StreamInterceptor(logStreamCalls)),
+		service: &service{shutdown: make(chan struct{})},
+	}
+	RegisterDrawyDrawServer(server.Server, server.service)
+	return server
+}
+
+// Shutdown ends the watch streams so their clients reconnect to another server, then waits for the calls in progress
+// to finish. The calls still running when ctx is done are cancelled.
+func (server *Server) Shutdown(ctx context.Context) {
+	server.service.shutdownOnce.Do(func() {
+		close(server.service.shutdown)
+	})
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
+}
+
+type service struct {
+	UnimplementedDrawyDrawServer
+	// Closed when the server shuts down, watch streams would keep a graceful stop waiting otherwise
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+}
+
+// Requests are validated with the same rules as the HTTP API, fields are named like they are in drawydraw.proto
+type playerIdentity struct {
+	GroupName  string `json:"group_name" validate:"required,max=30,name" normalize:"text"`
+	PlayerName string `json:"player_name" validate:"required,max=30,name" normalize:"text"`
+}
+
+type groupIdentity struct {
+	GroupName string `json:"group_name" validate:"required,max=30,name" normalize:"text"`
+}
+
+type promptWords struct {
+	Noun       string `json:"noun" validate:"required,max=30,word" normalize:"text"`
+	Adjective1 string `json:"adjective1" validate:"required,max=30,word" normalize:"text"`
+	Adjective2 string `json:"adjective2" validate:"required,max=30,word" normalize:"text"`
+}
+
+type gameSettings struct {
+	RoundCount              uint32 `json:"round_count" validate:"max=100"`
+	DrawingTimeLimitSeconds uint32 `json:"drawing_time_limit_seconds" validate:"max=3600"`
+}
+
+type pauseReason struct {
+	Reason string `json:"reason" validate:"max=200" normalize:"text"`
+}
+
+type languageTag struct {
+	Language string `json:"language" validate:"required,max=35" normalize:"text"`
+}
+
+type promptSelection struct {
+	SelectedPromptID string `json:"selected_prompt_id" validate:"required,numeric,max=20"`
+}
+
+type gameIdentity struct {
+	GameID string `json:"game_id" validate:"required,max=64,identifier"`
+}
+
+type imageIdentity struct {
+	ImageID string `json:"image_id" validate:"required,len=64,hexadecimal"`
+}
+
+type timelapseOptions struct {
+	Author     string `json:"author" validate:"required,max=30,name" normalize:"text"`
+	FrameRate  uint32 `json:"frame_rate" validate:"max=30"`
+	MaxSeconds uint32 `json:"max_seconds" validate:"max=60"`
+}
+
+// Validates the parts of a request, normalizing their text fields, and returns an InvalidArgument status listing every invalid field
+func validateRequest(ctx context.Context, parts ...interface{}) error {
+	fieldErrors := validation.Errors{}
+	for _, part := range parts {
+		err := validation.Request(part)
+		partErrors, isValidationError := err.(validation.Errors)
+		if err != nil && !isValidationError {
+			return codeError(ctx, nil, statemanager.ErrorCodeInvalidRequest, err.Error(), nil)
+		}
+		fieldErrors = append(fieldErrors, partErrors...)
+	}
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	return codeError(ctx, nil, statemanager.ErrorCodeInvalidInput, fieldErrors.Error(), fieldErrors)
+}
+
+// Statuses for each error code, codes that aren't listed are internal errors
+var errorCodeStatuses = map[statemanager.ErrorCode]codes.Code{
+	statemanager.ErrorCodeInvalidRequest:     codes.InvalidArgument,
+	statemanager.ErrorCodeInvalidInput:       codes.InvalidArgument,
+	statemanager.ErrorCodeVotedForOwnDecoy:   codes.InvalidArgument,
+	statemanager.ErrorCodeGameNotFound:       codes.NotFound,
+	statemanager.ErrorCodeDrawingNotFound:    codes.NotFound,
+	statemanager.ErrorCodeTranscriptNotFound: codes.NotFound,
+	statemanager.ErrorCodeNoTimelapse:        codes.NotFound,
+	statemanager.ErrorCodePlayerNotInGame:    codes.PermissionDenied,
+	statemanager.ErrorCodeNotHost:            codes.PermissionDenied,
+	statemanager.ErrorCodeGroupAlreadyExists: codes.AlreadyExists,
+	statemanager.ErrorCodeHostAlreadyExists:  codes.AlreadyExists,
+	statemanager.ErrorCodeWrongState:         codes.FailedPrecondition,
+	statemanager.ErrorCodeGamePaused:         codes.FailedPrecondition,
+	statemanager.ErrorCodeAlreadySubmitted:   codes.FailedPrecondition,
+	statemanager.ErrorCodeNotEnoughPlayers:   codes.FailedPrecondition,
+	statemanager.ErrorCodeUnauthorized:       codes.Unauthenticated,
+}
+
+// Turns an error from the statemanager into a status with the code that matches it
+func statusError(ctx context.Context, player *playerIdentity, err error) error {
+	return codeError(ctx, player, statemanager.ErrorCodeOf(err), err.Error(), nil)
+}
+
+// Statuses carry the error code, a message in the player's language and any invalid fields as their details
+func codeError(ctx context.Context, player *playerIdentity, code statemanager.ErrorCode, message string, fieldErrors validation.Errors) error {
+	statusCode, found := errorCodeStatuses[code]
+	if !found {
+		statusCode = codes.Internal
+	}
+	detail := &ErrorDetail{
+		Code:    string(code),
+		Message: localization.ErrorMessage(playerLanguage(ctx, player), string(code)),
+	}
+	for _, fieldError := range fieldErrors {
+		detail.Fields = append(detail.Fields, &FieldError{Field: fieldError.Field, Rule: fieldError.Rule, Message: fieldError.Message})
+	}
+	errorStatus, err := status.New(statusCode, message).WithDetails(detail)
+	if err != nil {
+		return status.Error(statusCode, message)
+	}
+	return errorStatus.Err()
+}
+
+// Players get messages in the language they picked, otherwise the one their client asks for with accept-language metadata
+func playerLanguage(ctx context.Context, player *playerIdentity) localization.Language {
+	if player != nil {
+		language, found := statemanager.GetPlayerLanguage(player.GroupName, player.PlayerName)
+		if found {
+			return language
+		}
+	}
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	return localization.FromAcceptLanguage(strings.Join(incoming.Get("accept-language"), ","))
+}
+
+func gameStatusResult(ctx context.Context, player *playerIdentity, gameStatus *statemanager.GameStatusResponse, err error) (*GameStatus, error) {
+	if err != nil {
+		return nil, statusError(ctx, player, err)
+	}
+	return gameStatusMessage(gameStatus), nil
+}
+
+func (*service) CreateGroup(ctx context.Context, request *CreateGroupRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	settings := &gameSettings{RoundCount: request.RoundCount, DrawingTimeLimitSeconds: request.DrawingTimeLimitSeconds}
+	err := validateRequest(ctx, player, settings)
+	if err != nil {
+		return nil, err
+	}
+	err = statemanager.CreateGroup(ctx, player.GroupName, models.GameSettings{
+		AllowSelfVotes:   request.AllowSelfVotes,
+		RoundCount:       uint(settings.RoundCount),
+		DrawingTimeLimit: time.Duration(settings.DrawingTimeLimitSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, statusError(ctx, player, err)
+	}
+	gameStatus, err := statemanager.AddPlayer(ctx, player.PlayerName, player.GroupName, true)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) AddPlayer(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.AddPlayer(ctx, player.PlayerName, player.GroupName, false)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) GetGameStatus(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.GetGameState(ctx, player.GroupName, player.PlayerName)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) StartGame(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.StartGame(ctx, player.GroupName, player.PlayerName)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) AddBot(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.AddBot(ctx, player.GroupName, player.PlayerName)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) AddPrompt(ctx context.Context, request *AddPromptRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	prompt := &promptWords{Noun: request.Noun, Adjective1: request.Adjective1, Adjective2: request.Adjective2}
+	err := validateRequest(ctx, player, prompt)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.AddPrompt(ctx, player.PlayerName, player.GroupName, prompt.Noun, prompt.Adjective1, prompt.Adjective2)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) SubmitDrawing(ctx context.Context, request *DrawingRequest) (*GameStatus, error) {
+	return submitDrawing(ctx, request, statemanager.SubmitDrawing, statemanager.SubmitStrokeDrawing)
+}
+
+func (*service) SaveDraftDrawing(ctx context.Context, request *DrawingRequest) (*GameStatus, error) {
+	return submitDrawing(ctx, request, statemanager.SaveDraftDrawing, statemanager.SaveDraftStrokeDrawing)
+}
+
+// Drawings and drafts are both either image data or strokes
+func submitDrawing(
+	ctx context.Context,
+	request *DrawingRequest,
+	submitImage func(ctx context.Context, playerName string, groupName string, imageData string) (*statemanager.GameStatusResponse, error),
+	submitStrokes func(ctx context.Context, playerName string, groupName string, strokes []*statemanager.Stroke) (*statemanager.GameStatusResponse, error),
+) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	var gameStatus *statemanager.GameStatusResponse
+	switch drawing := request.Drawing.(type) {
+	case *DrawingRequest_ImageData:
+		gameStatus, err = submitImage(ctx, player.PlayerName, player.GroupName, drawing.ImageData)
+	case *DrawingRequest_Strokes:
+		gameStatus, err = submitStrokes(ctx, player.PlayerName, player.GroupName, strokesFromMessages(drawing.Strokes.GetStrokes()))
+	default:
+		missingDrawing := validation.Errors{{Field: "drawing", Rule: "required", Message: "is required"}}
+		return nil, codeError(ctx, player, statemanager.ErrorCodeInvalidInput, missingDrawing.Error(), missingDrawing)
+	}
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) CastVote(ctx context.Context, request *CastVoteRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	selection := &promptSelection{SelectedPromptID: request.SelectedPromptId}
+	err := validateRequest(ctx, player, selection)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.CastVote(ctx, player.PlayerName, player.GroupName, selection.SelectedPromptID)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) PauseGame(ctx context.Context, request *PauseGameRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	reason := &pauseReason{Reason: request.Reason}
+	err := validateRequest(ctx, player, reason)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.PauseGame(ctx, player.GroupName, player.PlayerName, reason.Reason)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) ResumeGame(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.ResumeGame(ctx, player.GroupName, player.PlayerName)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) Rematch(ctx context.Context, request *PlayerRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.Rematch(ctx, player.GroupName, player.PlayerName)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) SetLanguage(ctx context.Context, request *SetLanguageRequest) (*GameStatus, error) {
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	language := &languageTag{Language: request.Language}
+	err := validateRequest(ctx, player, language)
+	if err != nil {
+		return nil, err
+	}
+	gameStatus, err := statemanager.SetPlayerLanguage(ctx, player.GroupName, player.PlayerName, language.Language)
+	return gameStatusResult(ctx, player, gameStatus, err)
+}
+
+func (*service) GetGameHistory(ctx context.Context, request *GroupRequest) (*GameHistory, error) {
+	group := &groupIdentity{GroupName: request.GroupName}
+	err := validateRequest(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	history, err := statemanager.GetGameHistory(ctx, group.GroupName)
+	if err != nil {
+		return nil, statusError(ctx, nil, err)
+	}
+	return gameHistoryMessage(history), nil
+}
+
+func (*service) GetGameTranscript(ctx context.Context, request *GetGameTranscriptRequest) (*Transcript, error) {
+	group := &groupIdentity{GroupName: request.GroupName}
+	game := &gameIdentity{GameID: request.GameId}
+	err := validateRequest(ctx, group, game)
+	if err != nil {
+		return nil, err
+	}
+	transcript, err := statemanager.GetGameTranscript(ctx, group.GroupName, game.GameID)
+	if err != nil {
+		return nil, statusError(ctx, nil, err)
+	}
+	return transcriptMessage(transcript), nil
+}
+
+func (*service) GetDrawingImage(ctx context.Context, request *GetDrawingImageRequest) (*Image, error) {
+	image := &imageIdentity{ImageID: request.ImageId}
+	err := validateRequest(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	drawing, err := statemanager.GetDrawingImage(ctx, image.ImageID)
+	if err != nil {
+		return nil, statusError(ctx, nil, err)
+	}
+	return &Image{MediaType: drawing.MediaType, Data: drawing.Data}, nil
+}
+
+// The frame rate and length are optional, the configured timelapse options are used for the ones left out
+func (*service) GetDrawingTimelapse(ctx context.Context, request *GetDrawingTimelapseRequest) (*Image, error) {
+	group := &groupIdentity{GroupName: request.GroupName}
+	requestedOptions := &timelapseOptions{Author: request.Author, FrameRate: request.FrameRate, MaxSeconds: request.MaxSeconds}
+	err := validateRequest(ctx, group, requestedOptions)
+	if err != nil {
+		return nil, err
+	}
+	options := images.GetTimelapseOptions()
+	if requestedOptions.FrameRate != 0 {
+		options.FrameRate = int(requestedOptions.FrameRate)
+	}
+	if requestedOptions.MaxSeconds != 0 {
+		options.MaxLength = time.Duration(requestedOptions.MaxSeconds) * time.Second
+	}
+	timelapse, err := statemanager.GetDrawingTimelapse(ctx, group.GroupName, requestedOptions.Author, options)
+	if err != nil {
+		return nil, statusError(ctx, nil, err)
+	}
+	return &Image{MediaType: "image/gif", Data: timelapse}, nil
+}
+
+// WatchGame sends the game's status whenever it's saved with changes the player can see, and when the drawing
+// time runs out or a bot's turn comes since those only happen once the game is loaded again.
+// It ends with Unavailable when the server shuts down.
+func (service *service) WatchGame(request *PlayerRequest, stream DrawyDraw_WatchGameServer) error {
+	ctx := stream.Context()
+	player := &playerIdentity{GroupName: request.GroupName, PlayerName: request.PlayerName}
+	err := validateRequest(ctx, player)
+	if err != nil {
+		return err
+	}
+	changes, stopWatching := statemanager.WatchGame(player.GroupName)
+	defer stopWatching()
+	var lastStatus *GameStatus
+	for {
+		gameStatus, err := statemanager.GetGameState(ctx, player.GroupName, player.PlayerName)
+		if err != nil {
+			return statusError(ctx, player, err)
+		}
+		message := gameStatusMessage(gameStatus)
+		if lastStatus == nil || !proto.Equal(message, lastStatus) {
+			err = stream.Send(message)
+			if err != nil {
+				return err
+			}
+			lastStatus = message
+		}
+		err = waitForChange(ctx, service.shutdown, changes, gameStatus)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func waitForChange(ctx context.Context, shutdown <-chan struct{}, changes <-chan struct{}, gameStatus *statemanager.GameStatusResponse) error {
+	var nextChange *time.Time
+	if gameStatus.DrawingDeadline != nil && !gameStatus.Paused {
+		nextChange = gameStatus.DrawingDeadline
+	}
+	if gameStatus.BotTurnAt != nil && (nextChange == nil || gameStatus.BotTurnAt.Before(*nextChange)) {
+		nextChange = gameStatus.BotTurnAt
+	}
+	var changeIsDue <-chan time.Time
+	if nextChange != nil {
+		timer := time.NewTimer(time.Until(*nextChange))
+		defer timer.Stop()
+		changeIsDue = timer.C
+	}
+	select {
+	case <-changes:
+		return nil
+	case <-changeIsDue:
+		return nil
+	case <-shutdown:
+		return status.Error(codes.Unavailable, "the server is shutting down")
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,245 @@
+package grpcapi
+
+import (
+	"context"
+	"drawydraw/localization"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"drawydraw/test"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Starts the service on an in-process listener and returns a client connected to it
+func setupTestClient(t *testing.T) DrawyDrawClient {
+	_, client := setupTestServer(t)
+	return client
+}
+
+// Starts the service on an in-process listener and returns the server along with a client connected to it
+func setupTestServer(t *testing.T) (*Server, DrawyDrawClient) {
+	test.SetupTestGameProvider(t)
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer()
+	go server.Serve(listener)
+	connection, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial the test server: %v", err)
+	}
+	t.Cleanup(func() {
+		// Closing the connection ends the calls in progress, they have to finish before the game provider is put back
+		connection.Close()
+		server.GracefulStop()
+	})
+	return server, NewDrawyDrawClient(connection)
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func errorDetail(t *testing.T, err error) *ErrorDetail {
+	errorStatus := status.Convert(err)
+	for _, detail := range errorStatus.Details() {
+		if errorDetail, isErrorDetail := detail.(*ErrorDetail); isErrorDetail {
+			return errorDetail
+		}
+	}
+	t.Fatalf("Status has no error detail: %v", err)
+	return nil
+}
+
+func TestCreateGroup_AddsHost(t *testing.T) {
+	client := setupTestClient(t)
+	ctx := testContext(t)
+	gameStatus, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada", RoundCount: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, "cats", gameStatus.GroupName)
+	assert.Equal(t, string(models.WaitingForPlayers), gameStatus.CurrentState)
+	assert.Equal(t, "Ada", gameStatus.CurrentPlayer.Name)
+	assert.True(t, gameStatus.CurrentPlayer.IsHost)
+	assert.Equal(t, uint(2), models.GetGameProvider().LoadGame("cats").Settings.RoundCount)
+}
+
+func TestCalls_SendRequestID(t *testing.T) {
+	client := setupTestClient(t)
+	logOutput := test.SetupTestLogger(t, logging.LevelInfo)
+	ctx := metadata.AppendToOutgoingContext(testContext(t), requestIDMetadataKey, "request-1")
+	var header metadata.MD
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"}, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"request-1"}, header.Get(requestIDMetadataKey))
+	assert.Contains(t, logOutput.String(), `"message":"gRPC call"`)
+	assert.Contains(t, logOutput.String(), `"operation":"CreateGroup"`)
+	for _, line := range strings.Split(strings.TrimSpace(logOutput.String()), "\n") {
+		assert.Contains(t, line, `"requestId":"request-1"`)
+	}
+}
+
+func TestStartGame_AfterPlayersJoin_Starts(t *testing.T) {
+	client := setupTestClient(t)
+	ctx := testContext(t)
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	for _, playerName := range []string{"Grace", "Alan"} {
+		_, err = client.AddPlayer(ctx, &PlayerRequest{GroupName: "cats", PlayerName: playerName})
+		assert.Nil(t, err)
+	}
+	gameStatus, err := client.StartGame(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	assert.Equal(t, string(models.InitialPromptCreation), gameStatus.CurrentState)
+	assert.Len(t, gameStatus.Players, 3)
+}
+
+func TestStartGame_NotEnoughPlayers_FailsWithCode(t *testing.T) {
+	client := setupTestClient(t)
+	ctx := testContext(t)
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	_, err = client.StartGame(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	detail := errorDetail(t, err)
+	assert.Equal(t, string(statemanager.ErrorCodeNotEnoughPlayers), detail.Code)
+	assert.NotEmpty(t, detail.Message)
+}
+
+func TestGetGameStatus_MissingGroup_IsNotFound(t *testing.T) {
+	client := setupTestClient(t)
+	_, err := client.GetGameStatus(testContext(t), &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, string(statemanager.ErrorCodeGameNotFound), errorDetail(t, err).Code)
+}
+
+func TestAddPlayer_InvalidFields_ListsThem(t *testing.T) {
+	client := setupTestClient(t)
+	_, err := client.AddPlayer(testContext(t), &PlayerRequest{GroupName: "<cats>"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	detail := errorDetail(t, err)
+	assert.Equal(t, string(statemanager.ErrorCodeInvalidInput), detail.Code)
+	assert.Len(t, detail.Fields, 2)
+	assert.Equal(t, "group_name", detail.Fields[0].Field)
+	assert.Equal(t, "name", detail.Fields[0].Rule)
+	assert.Equal(t, "player_name", detail.Fields[1].Field)
+	assert.Equal(t, "required", detail.Fields[1].Rule)
+}
+
+func TestErrors_UseAcceptLanguage(t *testing.T) {
+	client := setupTestClient(t)
+	ctx := metadata.AppendToOutgoingContext(testContext(t), "accept-language", "es")
+	_, err := client.GetGameStatus(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	expectedMessage := localization.ErrorMessage(localization.Spanish, string(statemanager.ErrorCodeGameNotFound))
+	assert.Equal(t, expectedMessage, errorDetail(t, err).Message)
+}
+
+func TestSubmitDrawing_MissingDrawing_Fails(t *testing.T) {
+	client := setupTestClient(t)
+	_, err := client.SubmitDrawing(testContext(t), &DrawingRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "drawing", errorDetail(t, err).Fields[0].Field)
+}
+
+func TestGetGameTranscript_InvalidGameID_Fails(t *testing.T) {
+	client := setupTestClient(t)
+	_, err := client.GetGameTranscript(testContext(t), &GetGameTranscriptRequest{GroupName: "cats", GameId: "../games"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	detail := errorDetail(t, err)
+	assert.Equal(t, "game_id", detail.Fields[0].Field)
+	assert.Equal(t, "identifier", detail.Fields[0].Rule)
+}
+
+func TestGetDrawingImage_InvalidImageID_Fails(t *testing.T) {
+	client := setupTestClient(t)
+	_, err := client.GetDrawingImage(testContext(t), &GetDrawingImageRequest{ImageId: "missing"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "image_id", errorDetail(t, err).Fields[0].Field)
+}
+
+func TestWatchGame_SendsChanges(t *testing.T) {
+	client := setupTestClient(t)
+	ctx := testContext(t)
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	stream, err := client.WatchGame(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	gameStatus, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.Players, 1)
+	_, err = client.AddPlayer(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Grace"})
+	assert.Nil(t, err)
+	gameStatus, err = stream.Recv()
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.Players, 2)
+}
+
+func TestShutdown_EndsWatchStreams(t *testing.T) {
+	server, client := setupTestServer(t)
+	ctx := testContext(t)
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	stream, err := client.WatchGame(ctx, &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Nil(t, err)
+	shutdownContext, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	server.Shutdown(shutdownContext)
+	assert.Nil(t, shutdownContext.Err(), "the watch stream kept the server from stopping gracefully")
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestWatchGame_MissingGroup_Fails(t *testing.T) {
+	client := setupTestClient(t)
+	stream, err := client.WatchGame(testContext(t), &PlayerRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchGame_SendsBotTurns(t *testing.T) {
+	client := setupTestClient(t)
+	previousDelay := statemanager.GetBotDelay()
+	statemanager.SetBotDelay(10 * time.Millisecond)
+	t.Cleanup(func() {
+		statemanager.SetBotDelay(previousDelay)
+	})
+	ctx := testContext(t)
+	host := &PlayerRequest{GroupName: "cats", PlayerName: "Ada"}
+	_, err := client.CreateGroup(ctx, &CreateGroupRequest{GroupName: "cats", PlayerName: "Ada"})
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = client.AddBot(ctx, host)
+		assert.Nil(t, err)
+	}
+	_, err = client.StartGame(ctx, host)
+	assert.Nil(t, err)
+	stream, err := client.WatchGame(ctx, host)
+	assert.Nil(t, err)
+	_, err = client.AddPrompt(ctx, &AddPromptRequest{GroupName: "cats", PlayerName: "Ada", Noun: "tuna", Adjective1: "stinky", Adjective2: "yummy"})
+	assert.Nil(t, err)
+	// Nobody else loads the game, the stream wakes up for the bots' turns
+	for {
+		gameStatus, err := stream.Recv()
+		if !assert.Nil(t, err) || gameStatus.CurrentState == string(models.DrawingsInProgress) {
+			break
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package httpapi
+
+import (
+	"crypto/subtle"
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminToken is the bearer token operators use for the admin routes, they're disabled when it's empty
+var adminToken string
+
+// SetAdminToken sets the bearer token operators use for the admin routes, an empty one disables them
+func SetAdminToken(token string) {
+	adminToken = token
+}
+
+func setupAdminRoutes(router *gin.Engine) {
+	admin := router.Group("/admin", requireAdminToken)
+	admin.GET("/games", listGames)
+	admin.GET("/games/:groupName", getGame)
+	admin.POST("/games/:groupName/advance", advanceGame)
+	admin.POST("/games/:groupName/reset", resetGame)
+	admin.DELETE("/games/:groupName", deleteGame)
+}
+
+func requireAdminToken(ctx *gin.Context) {
+	if adminToken == "" {
+		abortWithErrorResponse(ctx, http.StatusUnauthorized, formatError(ctx, statemanager.ErrorCodeUnauthorized, "The admin API is disabled, set ADMIN_TOKEN to enable it"))
+		return
+	}
+	authorization := ctx.GetHeader("Authorization")
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	if token == authorization || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		abortWithErrorResponse(ctx, http.StatusUnauthorized, formatError(ctx, statemanager.ErrorCodeUnauthorized, "Missing or wrong admin token"))
+		return
+	}
+	ctx.Next()
+}
+
+// adminGameSummary is what operators see of each game when listing them
+type adminGameSummary struct {
+	GroupName    string           `json:"groupName"`
+	GameID       string           `json:"gameId"`
+	CurrentState models.GameState `json:"currentState"`
+	PlayerCount  int              `json:"playerCount"`
+	Paused       bool             `json:"paused"`
+	StartedAt    time.Time        `json:"startedAt"`
+}
+
+type adminGameListResponse struct {
+	Games []*adminGameSummary `json:"games"`
+}
+
+func listGames(ctx *gin.Context) {
+	games := statemanager.ListGames()
+	summaries := make([]*adminGameSummary, len(games))
+	for i, game := range games {
+		summaries[i] = &adminGameSummary{
+			GroupName:    game.GroupName,
+			GameID:       game.ID,
+			CurrentState: game.CurrentState,
+			PlayerCount:  len(game.Players),
+			Paused:       game.Paused,
+			StartedAt:    game.StartedAt,
+		}
+	}
+	respond(ctx, &adminGameListResponse{Games: summaries})
+}
+
+func getGame(ctx *gin.Context) {
+	game, err := statemanager.GetGame(ctx.Request.Context(), ctx.Param("groupName"))
+	if err != nil {
+		abortWithError(ctx, "Error getting game", err)
+		return
+	}
+	respond(ctx, game)
+}
+
+func advanceGame(ctx *gin.Context) {
+	game, err := statemanager.AdvanceGame(ctx.Request.Context(), ctx.Param("groupName"))
+	if err != nil {
+		abortWithError(ctx, "Error advancing game", err)
+		return
+	}
+	respond(ctx, game)
+}
+
+func resetGame(ctx *gin.Context) {
+	game, err := statemanager.ResetGame(ctx.Request.Context(), ctx.Param("groupName"))
+	if err != nil {
+		abortWithError(ctx, "Error resetting game", err)
+		return
+	}
+	respond(ctx, game)
+}
+
+func deleteGame(ctx *gin.Context) {
+	err := statemanager.DeleteGame(ctx.Request.Context(), ctx.Param("groupName"))
+	if err != nil {
+		abortWithError(ctx, "Error deleting game", err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
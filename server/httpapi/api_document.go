@@ -0,0 +1,113 @@
+package httpapi
+
+import (
+	"drawydraw/archive"
+	"drawydraw/models"
+	"drawydraw/openapi"
+	"drawydraw/statemanager"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Every API route is described here, TestAPIDocument_MatchesRoutes fails when a route is missing
+func newAPIDocument() *openapi.Document {
+	document := openapi.NewDocument(&openapi.Info{
+		Title:       "Drawy Draw API",
+		Description: "Players join a group, write prompts, draw them and vote on what each drawing is.",
+		Version:     "2.0.0",
+	})
+	errorCodes := []string{string(statemanager.ErrorCodeInternal)}
+	for code := range errorCodeStatuses {
+		errorCodes = append(errorCodes, string(code))
+	}
+	sort.Strings(errorCodes)
+	document.SetEnum(statemanager.ErrorCode(""), errorCodes)
+
+	gameStatus := &statemanager.GameStatusResponse{}
+	routes := []*openapi.Route{
+		{Method: "GET", Path: "/api/hello", OperationID: "hello", Summary: "Checks that the server is up", Response: map[string]string{}},
+		{Method: "GET", Path: "/api/openapi.json", OperationID: "getAPIDocument", Summary: "Gets this document", Response: map[string]interface{}{}},
+		{Method: "GET", Path: "/healthz", OperationID: "checkHealth", Summary: "Checks that the server is running", Response: &healthResponse{}},
+		{Method: "GET", Path: "/readyz", OperationID: "checkReadiness", Summary: "Checks that the server can take requests, it can't while shutting down or when games can't be stored", Response: &healthResponse{}},
+		{Method: "GET", Path: "/metrics", OperationID: "getMetrics", Summary: "Gets the server's metrics in the Prometheus text format", ResponseMediaType: "text/plain"},
+		{Method: "GET", Path: "/api/get-game-status/:groupName", OperationID: "getGameStatus", Summary: "Gets the status of a game for a player", Request: &getGameStatusRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/add-player", OperationID: "addPlayer", Summary: "Joins a game", Request: &addPlayerRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/add-bot", OperationID: "addBot", Summary: "Adds a bot player while waiting for players, only the host can", Request: &addBotRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/create-game", OperationID: "createGame", Summary: "Creates a group and joins it as its host", Request: &createGroupRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/start-game", OperationID: "startGame", Summary: "Starts the game once everyone has joined", Request: &startGameRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/add-prompt", OperationID: "addPrompt", Summary: "Writes a prompt, or a decoy prompt while voting is being set up", Request: &addPromptRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/submit-drawing", OperationID: "submitDrawing", Summary: "Submits a drawing as an image or as strokes", Request: &submitDrawingRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/save-draft-drawing", OperationID: "saveDraftDrawing", Summary: "Saves a drawing in progress, it's submitted when the drawing time runs out", Request: &submitDrawingRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/cast-vote", OperationID: "castVote", Summary: "Votes for the prompt a drawing was made from", Request: &castVoteRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/pause-game", OperationID: "pauseGame", Summary: "Pauses the game, only the host can", Request: &pauseGameRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/resume-game", OperationID: "resumeGame", Summary: "Resumes a paused game, only the host can", Request: &resumeGameRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/rematch", OperationID: "rematch", Summary: "Starts a new game with the same players once the game is over", Request: &rematchRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/set-language", OperationID: "setLanguage", Summary: "Picks the language a player gets messages in", Request: &setLanguageRequest{}, Response: gameStatus},
+		{Method: "GET", Path: "/api/get-game-history/:groupName", OperationID: "getGameHistory", Summary: "Lists the finished games of a group", Request: &getGameHistoryRequest{}, Response: &gameHistoryResponse{}},
+		{Method: "GET", Path: "/api/get-game-transcript/:groupName/:gameId", OperationID: "getGameTranscript", Summary: "Gets everything that happened in a finished game", Request: &getGameTranscriptRequest{}, Response: &archive.Transcript{}},
+		{Method: "GET", Path: "/api/export-game/:groupName/:gameId", OperationID: "exportGame", Summary: "Downloads a finished game as a gallery", Request: &getGameTranscriptRequest{}, ResponseMediaType: "application/zip"},
+		{Method: "GET", Path: "/api/images/:imageId", OperationID: "getDrawingImage", Summary: "Gets the image of a drawing", Request: &getDrawingImageRequest{}, ResponseMediaType: "image/*"},
+		{Method: "GET", Path: "/api/get-drawing-timelapse/:groupName", OperationID: "getDrawingTimelapse", Summary: "Gets an animation of a drawing being drawn", Request: &getDrawingTimelapseRequest{}, ResponseMediaType: "image/gif"},
+	}
+	routes = append(routes, debugAPIRoutes()...)
+	for _, route := range routes {
+		route.ErrorResponse = &errorResponse{}
+		document.AddRoute(route)
+	}
+
+	v2Routes := []*openapi.Route{
+		{Method: "POST", Path: "/api/v2/groups", OperationID: "v2CreateGroup", Summary: "Creates a group and joins it as its host", Request: &createGroupRequest{}, Response: gameStatus},
+		{Method: "GET", Path: "/api/v2/groups/:groupName", OperationID: "v2GetGameStatus", Summary: "Gets the status of a game for a player", Request: &getGameStatusRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/players", OperationID: "v2AddPlayer", Summary: "Joins a game", Request: &addPlayerRequest{}, Response: gameStatus},
+		{Method: "PUT", Path: "/api/v2/groups/:groupName/players/me/language", OperationID: "v2SetLanguage", Summary: "Picks the language a player gets messages in", Request: &setLanguageRequest{}, Response: gameStatus},
+		{Method: "PUT", Path: "/api/v2/groups/:groupName/players/me/draft-drawing", OperationID: "v2SaveDraftDrawing", Summary: "Saves a drawing in progress, it's submitted when the drawing time runs out", Request: &submitDrawingRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/bots", OperationID: "v2AddBot", Summary: "Adds a bot player while waiting for players, only the host can", Request: &addBotRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/rounds", OperationID: "v2StartGame", Summary: "Starts the game once everyone has joined", Request: &startGameRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/rounds/current/votes", OperationID: "v2CastVote", Summary: "Votes for the prompt a drawing was made from", Request: &castVoteRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/prompts", OperationID: "v2AddPrompt", Summary: "Writes a prompt, or a decoy prompt while voting is being set up", Request: &addPromptRequest{}, Response: gameStatus},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/drawings", OperationID: "v2SubmitDrawing", Summary: "Submits a drawing as an image or as strokes", Request: &submitDrawingRequest{}, Response: gameStatus},
+		{Method: "GET", Path: "/api/v2/groups/:groupName/drawings/:author/timelapse", OperationID: "v2GetDrawingTimelapse", Summary: "Gets an animation of a drawing being drawn", Request: &getDrawingTimelapseRequest{}, ResponseMediaType: "image/gif"},
+		{Method: "PUT", Path: "/api/v2/groups/:groupName/pause", OperationID: "v2PauseGame", Summary: "Pauses the game, only the host can", Request: &pauseGameRequest{}, Response: gameStatus},
+		{Method: "DELETE", Path: "/api/v2/groups/:groupName/pause", OperationID: "v2ResumeGame", Summary: "Resumes a paused game, only the host can", Request: &resumeGameRequest{}, Response: gameStatus},
+		{Method: "GET", Path: "/api/v2/groups/:groupName/games", OperationID: "v2GetGameHistory", Summary: "Lists the finished games of a group", Request: &getGameHistoryRequest{}, Response: &gameHistoryResponse{}},
+		{Method: "POST", Path: "/api/v2/groups/:groupName/games", OperationID: "v2Rematch", Summary: "Starts a new game with the same players once the game is over", Request: &rematchRequest{}, Response: gameStatus},
+		{Method: "GET", Path: "/api/v2/groups/:groupName/games/:gameId", OperationID: "v2GetGameTranscript", Summary: "Gets everything that happened in a finished game", Request: &getGameTranscriptRequest{}, Response: &archive.Transcript{}},
+		{Method: "GET", Path: "/api/v2/groups/:groupName/games/:gameId/gallery", OperationID: "v2ExportGame", Summary: "Downloads a finished game as a gallery", Request: &getGameTranscriptRequest{}, ResponseMediaType: "application/zip"},
+		{Method: "GET", Path: "/api/v2/images/:imageId", OperationID: "v2GetDrawingImage", Summary: "Gets the image of a drawing", Request: &getDrawingImageRequest{}, ResponseMediaType: "image/*"},
+	}
+	for _, route := range v2Routes {
+		route.HeaderParameters = true
+		if route.Response != nil {
+			route.ResponseEnvelope = "data"
+		}
+		if route.Method == "POST" {
+			route.SuccessStatus = http.StatusCreated
+		}
+		route.ErrorResponse = &errorEnvelope{}
+		document.AddRoute(route)
+	}
+
+	document.AddSecurityScheme("adminToken", &openapi.SecurityScheme{Type: "http", Scheme: "bearer"})
+	game := &models.Game{}
+	adminRoutes := []*openapi.Route{
+		{Method: "GET", Path: "/admin/games", OperationID: "adminListGames", Summary: "Lists every active game", Response: &adminGameListResponse{}},
+		{Method: "GET", Path: "/admin/games/:groupName", OperationID: "adminGetGame", Summary: "Gets everything the server keeps about a game", Response: game},
+		{Method: "POST", Path: "/admin/games/:groupName/advance", OperationID: "adminAdvanceGame", Summary: "Moves a game to its next state, skipping players who haven't acted", Response: game},
+		{Method: "POST", Path: "/admin/games/:groupName/reset", OperationID: "adminResetGame", Summary: "Starts a game over with the same players", Response: game},
+		{Method: "DELETE", Path: "/admin/games/:groupName", OperationID: "adminDeleteGame", Summary: "Deletes a game", SuccessStatus: http.StatusNoContent},
+	}
+	for _, route := range adminRoutes {
+		route.Security = "adminToken"
+		route.ErrorResponse = &errorResponse{}
+		document.AddRoute(route)
+	}
+	return document
+}
+
+func serveAPIDocument(document *openapi.Document) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, document)
+	}
+}
@@ -0,0 +1,127 @@
+package httpapi
+
+import (
+	"drawydraw/statemanager"
+	"drawydraw/validation"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Version 2 of the API has resource routes for the same handlers as version 1.
+// The group and other resources are identified by the route, the player making a request
+// by the X-Player-Name header (percent-encoded), and responses are wrapped in envelopes.
+const (
+	apiVersionKey    = "apiVersion"
+	playerNameHeader = "X-Player-Name"
+)
+
+func setupV2Routes(router *gin.Engine) {
+	v2 := router.Group("/api/v2", useAPIVersion(2), decodePlayerNameHeader)
+	v2.POST("/groups", createGroup)
+	v2.GET("/groups/:groupName", getGameStatus)
+	v2.POST("/groups/:groupName/players", addPlayer)
+	v2.PUT("/groups/:groupName/players/me/language", setLanguage)
+	v2.PUT("/groups/:groupName/players/me/draft-drawing", saveDraftDrawing)
+	// Starting the game starts its first round
+	v2.POST("/groups/:groupName/bots", addBot)
+	v2.POST("/groups/:groupName/rounds", startGame)
+	v2.POST("/groups/:groupName/rounds/current/votes", castVote)
+	// Prompts are decoys once the drawings are done
+	v2.POST("/groups/:groupName/prompts", addPrompt)
+	v2.POST("/groups/:groupName/drawings", submitDrawing)
+	v2.GET("/groups/:groupName/drawings/:author/timelapse", getDrawingTimelapse)
+	v2.PUT("/groups/:groupName/pause", pauseGame)
+	v2.DELETE("/groups/:groupName/pause", resumeGame)
+	// Finished games are archived, a rematch starts a new one
+	v2.GET("/groups/:groupName/games", getGameHistory)
+	v2.POST("/groups/:groupName/games", rematch)
+	v2.GET("/groups/:groupName/games/:gameId", getGameTranscript)
+	v2.GET("/groups/:groupName/games/:gameId/gallery", exportGame)
+	v2.GET("/images/:imageId", getDrawingImage)
+}
+
+func useAPIVersion(version int) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set(apiVersionKey, version)
+	}
+}
+
+// Routes outside of a version group are version 1
+func requestAPIVersion(ctx *gin.Context) int {
+	version := ctx.GetInt(apiVersionKey)
+	if version == 0 {
+		return 1
+	}
+	return version
+}
+
+// Names can have any letter so they're percent-encoded to fit in a header
+func decodePlayerNameHeader(ctx *gin.Context) {
+	playerName := ctx.GetHeader(playerNameHeader)
+	if playerName == "" {
+		return
+	}
+	decodedName, err := url.PathUnescape(playerName)
+	if err != nil {
+		abortWithInvalidRequest(ctx, fmt.Errorf("%s is not percent-encoded: %s", playerNameHeader, err.Error()))
+		return
+	}
+	ctx.Request.Header.Set(playerNameHeader, decodedName)
+}
+
+// Reads the JSON body when there is one, then the route's parameters, query string and headers,
+// so the route and headers take precedence over the body
+func bindResourceRequest(ctx *gin.Context, request interface{}) error {
+	if ctx.Request.ContentLength != 0 && ctx.Request.Method != http.MethodGet {
+		err := ctx.ShouldBindJSON(request)
+		if err != nil {
+			return err
+		}
+	}
+	err := ctx.ShouldBindUri(request)
+	if err != nil {
+		return err
+	}
+	err = ctx.ShouldBindQuery(request)
+	if err != nil {
+		return err
+	}
+	return ctx.ShouldBindHeader(request)
+}
+
+type resultEnvelope struct {
+	Data interface{} `json:"data"`
+}
+
+// Requests that add something, like a player or a vote, respond with 201 Created
+func respondWithEnvelope(ctx *gin.Context, result interface{}) {
+	status := http.StatusOK
+	if ctx.Request.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	ctx.JSON(status, &resultEnvelope{Data: result})
+}
+
+type errorEnvelope struct {
+	Error *envelopedError `json:"error"`
+}
+
+type envelopedError struct {
+	Code    statemanager.ErrorCode `json:"code"`
+	Message string                 `json:"message"`
+	Detail  string                 `json:"detail"`
+	// Fields lists what's wrong with each invalid field of a request
+	Fields []*validation.FieldError `json:"fields,omitempty"`
+}
+
+func abortWithErrorEnvelope(ctx *gin.Context, status int, response *errorResponse) {
+	ctx.AbortWithStatusJSON(status, &errorEnvelope{Error: &envelopedError{
+		Code:    response.Code,
+		Message: response.Error,
+		Detail:  response.Detail,
+		Fields:  response.Fields,
+	}})
+}
@@ -0,0 +1,56 @@
+//go:build debug
+// +build debug
+
+package httpapi
+
+import (
+	"drawydraw/models"
+	"drawydraw/openapi"
+	"drawydraw/statemanager"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Debug routes help try out the client, they're only built into binaries built with `-tags debug`
+
+func setupDebugRoutes(router *gin.Engine) {
+	router.POST("/api/debug/load-fixture", loadFixture)
+}
+
+func debugAPIRoutes() []*openapi.Route {
+	return []*openapi.Route{
+		{Method: "POST", Path: "/api/debug/load-fixture", OperationID: "loadFixture", Summary: "Debug: replaces a group's game with one played up to a state", Request: &loadFixtureRequest{}, Response: &statemanager.GameStatusResponse{}},
+	}
+}
+
+// Players are named after playerNames, then "player4", "player5" and so on until there are at least playerCount of them
+type loadFixtureRequest struct {
+	GroupName   string   `json:"groupName" validate:"required,max=30,name" normalize:"text"`
+	GameState   string   `json:"gameState" validate:"required"`
+	PlayerCount int      `json:"playerCount" validate:"omitempty,min=3,max=12"`
+	PlayerNames []string `json:"playerNames" validate:"max=12,dive,required,max=30,name"`
+}
+
+func loadFixture(ctx *gin.Context) {
+	request := loadFixtureRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	playerCount := request.PlayerCount
+	if playerCount == 0 {
+		playerCount = 3
+	}
+	playerNames := request.PlayerNames
+	for len(playerNames) < playerCount {
+		playerNames = append(playerNames, fmt.Sprintf("player%d", len(playerNames)+1))
+	}
+	gameStatus, err := statemanager.LoadFixture(ctx.Request.Context(), request.GroupName, models.GameState(request.GameState), playerNames)
+	if err != nil {
+		abortWithError(ctx, "Error loading fixture", err)
+		return
+	}
+	respond(ctx, gameStatus)
+}
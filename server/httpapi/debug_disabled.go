@@ -0,0 +1,18 @@
+//go:build !debug
+// +build !debug
+
+package httpapi
+
+import (
+	"drawydraw/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Builds without the debug tag have no debug routes, see debug.go
+
+func setupDebugRoutes(router *gin.Engine) {}
+
+func debugAPIRoutes() []*openapi.Route {
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build debug
+// +build debug
+
+package httpapi
+
+import (
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"drawydraw/test"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadFixture(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	req := createRequest(t, "POST", "/api/debug/load-fixture", map[string]interface{}{
+		"groupName":   "cats",
+		"gameState":   "Voting",
+		"playerCount": 4,
+		"playerNames": []string{"mama cat", "papa cat"},
+	})
+	w := serveRequest(req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	gameStatus := &statemanager.GameStatusResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), gameStatus)
+	assert.Nil(t, err)
+	assert.Equal(t, "Voting", gameStatus.CurrentState)
+	assert.Equal(t, "mama cat", gameStatus.CurrentPlayer.Name)
+	playerNames := []string{}
+	for _, player := range models.GetGameProvider().LoadGame("cats").Players {
+		playerNames = append(playerNames, player.Name)
+	}
+	assert.Equal(t, []string{"mama cat", "papa cat", "player3", "player4"}, playerNames)
+}
+
+func TestLoadFixture_InvalidRequest_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	req := createRequest(t, "POST", "/api/debug/load-fixture", map[string]interface{}{"gameState": "Voting", "playerCount": 2})
+	w := serveRequest(req)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	req = createRequest(t, "POST", "/api/debug/load-fixture", map[string]interface{}{"groupName": "cats", "gameState": "Napping"})
+	w = serveRequest(req)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
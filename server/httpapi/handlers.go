@@ -0,0 +1,658 @@
+package httpapi
+
+import (
+	"bytes"
+	"drawydraw/archive"
+	"drawydraw/export"
+	"drawydraw/images"
+	"drawydraw/localization"
+	"drawydraw/metrics"
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"drawydraw/validation"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/contrib/static"
+	"github.com/gin-gonic/gin"
+)
+
+// NewRouter creates the router for every HTTP route, along with the client's static files
+func NewRouter() *gin.Engine {
+	// Requests are logged as JSON lines instead of with gin's logger
+	router := gin.New()
+	router.Use(logRequests, observeRequests, recoverPanics)
+
+	// Serve frontend static files
+	router.Use(static.Serve("/", static.LocalFile("./web", true)))
+
+	// API routes
+	router.GET("/api/hello", func(ctx *gin.Context) { ctx.JSON(http.StatusOK, gin.H{"hello": "there"}) })
+	router.GET("/api/openapi.json", serveAPIDocument(newAPIDocument()))
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/healthz", checkHealth)
+	router.GET("/readyz", checkReadiness)
+	router.GET("/api/get-game-status/:groupName", getGameStatus)
+	// Todo: Rename this to join-game
+	router.POST("/api/add-player", addPlayer)
+	router.POST("/api/add-bot", addBot)
+	router.POST("/api/create-game", createGroup)
+	router.POST("/api/start-game", startGame)
+	router.POST("/api/add-prompt", addPrompt)
+	router.POST("/api/submit-drawing", submitDrawing)
+	router.POST("/api/save-draft-drawing", saveDraftDrawing)
+	router.POST("/api/cast-vote", castVote)
+	router.POST("/api/pause-game", pauseGame)
+	router.POST("/api/resume-game", resumeGame)
+	router.POST("/api/rematch", rematch)
+	router.POST("/api/set-language", setLanguage)
+	router.GET("/api/get-game-history/:groupName", getGameHistory)
+	router.GET("/api/get-game-transcript/:groupName/:gameId", getGameTranscript)
+	router.GET("/api/export-game/:groupName/:gameId", exportGame)
+	router.GET("/api/images/:imageId", getDrawingImage)
+	router.GET("/api/get-drawing-timelapse/:groupName", getDrawingTimelapse)
+
+	setupV2Routes(router)
+	setupAdminRoutes(router)
+	setupDebugRoutes(router)
+	return router
+}
+
+// Todo: Probably move each handler / request schema to its own file
+type addPlayerRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func addPlayer(ctx *gin.Context) {
+	addPlayerRequest := addPlayerRequest{}
+	err := bindRequest(ctx, &addPlayerRequest)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, addPlayerRequest.GroupName, addPlayerRequest.PlayerName)
+	gameState, err := statemanager.AddPlayer(ctx.Request.Context(), addPlayerRequest.PlayerName, addPlayerRequest.GroupName, false)
+	if err != nil {
+		abortWithError(ctx, "Error adding player", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type addPromptRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	Noun       string `json:"noun" validate:"required,max=30,word" normalize:"text"`
+	Adjective1 string `json:"adjective1" validate:"required,max=30,word" normalize:"text"`
+	Adjective2 string `json:"adjective2" validate:"required,max=30,word" normalize:"text"`
+}
+
+func addPrompt(ctx *gin.Context) {
+	addPromptRequest := addPromptRequest{}
+	err := bindRequest(ctx, &addPromptRequest)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, addPromptRequest.GroupName, addPromptRequest.PlayerName)
+	gameState, err := statemanager.AddPrompt(ctx.Request.Context(), addPromptRequest.PlayerName, addPromptRequest.GroupName, addPromptRequest.Noun, addPromptRequest.Adjective1, addPromptRequest.Adjective2)
+	if err != nil {
+		abortWithError(ctx, "Error adding prompt", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+// Drawings are submitted either as an image data URL or as the strokes that make them up
+type submitDrawingRequest struct {
+	PlayerName string                 `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string                 `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	ImageData  string                 `json:"imageData" validate:"required_without=Strokes"`
+	Strokes    []*statemanager.Stroke `json:"strokes" validate:"required_without=ImageData"`
+}
+
+func submitDrawing(ctx *gin.Context) {
+	request := submitDrawingRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	var gameState *statemanager.GameStatusResponse
+	if request.Strokes != nil {
+		gameState, err = statemanager.SubmitStrokeDrawing(ctx.Request.Context(), request.PlayerName, request.GroupName, request.Strokes)
+	} else {
+		gameState, err = statemanager.SubmitDrawing(ctx.Request.Context(), request.PlayerName, request.GroupName, request.ImageData)
+	}
+	if err != nil {
+		abortWithError(ctx, "Error submitting drawing", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+func saveDraftDrawing(ctx *gin.Context) {
+	request := submitDrawingRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	var gameState *statemanager.GameStatusResponse
+	if request.Strokes != nil {
+		gameState, err = statemanager.SaveDraftStrokeDrawing(ctx.Request.Context(), request.PlayerName, request.GroupName, request.Strokes)
+	} else {
+		gameState, err = statemanager.SaveDraftDrawing(ctx.Request.Context(), request.PlayerName, request.GroupName, request.ImageData)
+	}
+	if err != nil {
+		abortWithError(ctx, "Error saving draft drawing", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type castVoteRequest struct {
+	PlayerName       string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName        string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	SelectedPromptID string `json:"selectedPromptId" validate:"required,numeric,max=20"`
+}
+
+func castVote(ctx *gin.Context) {
+	request := castVoteRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.CastVote(ctx.Request.Context(), request.PlayerName, request.GroupName, request.SelectedPromptID)
+	if err != nil {
+		abortWithError(ctx, "Error casting vote", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type getGameStatusRequest struct {
+	GroupName  string `uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	PlayerName string `form:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+}
+
+func getGameStatus(ctx *gin.Context) {
+	request := getGameStatusRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.GetGameState(ctx.Request.Context(), request.GroupName, request.PlayerName)
+	if err != nil {
+		abortWithError(ctx, "Error getting game status", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type gameHistoryResponse struct {
+	Games []*archive.Summary `json:"games"`
+}
+
+type getGameHistoryRequest struct {
+	GroupName string `uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func getGameHistory(ctx *gin.Context) {
+	request := getGameHistoryRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	history, err := statemanager.GetGameHistory(ctx.Request.Context(), request.GroupName)
+	if err != nil {
+		abortWithError(ctx, "Error getting game history", err)
+		return
+	}
+	respond(ctx, &gameHistoryResponse{Games: history})
+}
+
+// Finished games are looked up by the ID they were given when they started
+type getGameTranscriptRequest struct {
+	GroupName string `uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	GameID    string `uri:"gameId" validate:"required,max=64,identifier"`
+}
+
+func getGameTranscript(ctx *gin.Context) {
+	request := getGameTranscriptRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	transcript, err := statemanager.GetGameTranscript(ctx.Request.Context(), request.GroupName, request.GameID)
+	if err != nil {
+		abortWithError(ctx, "Error getting game transcript", err)
+		return
+	}
+	respond(ctx, transcript)
+}
+
+func exportGame(ctx *gin.Context) {
+	request := getGameTranscriptRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	transcript, err := statemanager.GetGameTranscript(ctx.Request.Context(), request.GroupName, request.GameID)
+	if err != nil {
+		abortWithError(ctx, "Error exporting game", err)
+		return
+	}
+	gallery := bytes.Buffer{}
+	err = export.WriteGallery(transcript, images.GetDrawingStore(), &gallery)
+	if err != nil {
+		abortWithError(ctx, "Error exporting game", err)
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"drawydraw-%s.zip\"", transcript.GameID))
+	ctx.Data(http.StatusOK, "application/zip", gallery.Bytes())
+}
+
+// Images are stored under the SHA-256 hash of their contents
+type getDrawingImageRequest struct {
+	ImageID string `uri:"imageId" validate:"required,len=64,hexadecimal"`
+}
+
+func getDrawingImage(ctx *gin.Context) {
+	request := getDrawingImageRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	drawing, err := statemanager.GetDrawingImage(ctx.Request.Context(), request.ImageID)
+	if err != nil {
+		abortWithError(ctx, "Error getting image", err)
+		return
+	}
+	// Images never change since they're stored under the hash of their contents, only images that exist are cached
+	etag := fmt.Sprintf("\"%s\"", request.ImageID)
+	ctx.Header("ETag", etag)
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	if ctx.GetHeader("If-None-Match") == etag {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+	ctx.Data(http.StatusOK, drawing.MediaType, drawing.Data)
+}
+
+// The frame rate and length are optional, the configured timelapse options are used for the ones left out
+type getDrawingTimelapseRequest struct {
+	GroupName  string `uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	Author     string `uri:"author" form:"author" validate:"required,max=30,name" normalize:"text"`
+	FrameRate  int    `form:"frameRate" validate:"min=0,max=30"`
+	MaxSeconds int    `form:"maxSeconds" validate:"min=0,max=60"`
+}
+
+func getDrawingTimelapse(ctx *gin.Context) {
+	request := getDrawingTimelapseRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	options := images.GetTimelapseOptions()
+	if request.FrameRate != 0 {
+		options.FrameRate = request.FrameRate
+	}
+	if request.MaxSeconds != 0 {
+		options.MaxLength = time.Duration(request.MaxSeconds) * time.Second
+	}
+	timelapse, err := statemanager.GetDrawingTimelapse(ctx.Request.Context(), request.GroupName, request.Author, options)
+	if err != nil {
+		abortWithError(ctx, "Error getting timelapse", err)
+		return
+	}
+	ctx.Data(http.StatusOK, "image/gif", timelapse)
+}
+
+// Games play statemanager.DefaultRoundCount rounds when roundCount is 0 or left out
+type createGroupRequest struct {
+	PlayerName              string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName               string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	AllowSelfVotes          bool   `json:"allowSelfVotes"`
+	RoundCount              uint   `json:"roundCount" validate:"max=100"`
+	DrawingTimeLimitSeconds uint   `json:"drawingTimeLimitSeconds" validate:"max=3600"`
+}
+
+func createGroup(ctx *gin.Context) {
+	createGroupRequest := createGroupRequest{}
+
+	err := bindRequest(ctx, &createGroupRequest)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, createGroupRequest.GroupName, createGroupRequest.PlayerName)
+
+	// Note: If CreateGroup succeeds but AddPlayer fails the group will be created and the host will be left out :(
+	settings := models.GameSettings{
+		AllowSelfVotes:   createGroupRequest.AllowSelfVotes,
+		RoundCount:       createGroupRequest.RoundCount,
+		DrawingTimeLimit: time.Duration(createGroupRequest.DrawingTimeLimitSeconds) * time.Second,
+	}
+	createGroupError := statemanager.CreateGroup(ctx.Request.Context(), createGroupRequest.GroupName, settings)
+	if createGroupError != nil {
+		abortWithError(ctx, "Error creating group", createGroupError)
+		return
+	}
+
+	gameState, addPlayerError := statemanager.AddPlayer(ctx.Request.Context(), createGroupRequest.PlayerName, createGroupRequest.GroupName, true)
+	if addPlayerError != nil {
+		abortWithError(ctx, "Error adding host", addPlayerError)
+		return
+	}
+
+	respond(ctx, gameState)
+}
+
+type startGameRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func startGame(ctx *gin.Context) {
+	request := startGameRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, startGameError := statemanager.StartGame(ctx.Request.Context(), request.GroupName, request.PlayerName)
+	if startGameError != nil {
+		abortWithError(ctx, "Error starting game", startGameError)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type addBotRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func addBot(ctx *gin.Context) {
+	request := addBotRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.AddBot(ctx.Request.Context(), request.GroupName, request.PlayerName)
+	if err != nil {
+		abortWithError(ctx, "Error adding bot", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type pauseGameRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	Reason     string `json:"reason" validate:"max=200" normalize:"text"`
+}
+
+func pauseGame(ctx *gin.Context) {
+	request := pauseGameRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.PauseGame(ctx.Request.Context(), request.GroupName, request.PlayerName, request.Reason)
+	if err != nil {
+		abortWithError(ctx, "Error pausing game", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type resumeGameRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func resumeGame(ctx *gin.Context) {
+	request := resumeGameRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.ResumeGame(ctx.Request.Context(), request.GroupName, request.PlayerName)
+	if err != nil {
+		abortWithError(ctx, "Error resuming game", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type rematchRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+}
+
+func rematch(ctx *gin.Context) {
+	request := rematchRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.Rematch(ctx.Request.Context(), request.GroupName, request.PlayerName)
+	if err != nil {
+		abortWithError(ctx, "Error starting rematch", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+type setLanguageRequest struct {
+	PlayerName string `json:"playerName" header:"X-Player-Name" validate:"required,max=30,name" normalize:"text"`
+	GroupName  string `json:"groupName" uri:"groupName" validate:"required,max=30,name" normalize:"text"`
+	Language   string `json:"language" validate:"required,max=35" normalize:"text"`
+}
+
+func setLanguage(ctx *gin.Context) {
+	request := setLanguageRequest{}
+	err := bindRequest(ctx, &request)
+	if err != nil {
+		abortWithInvalidRequest(ctx, err)
+		return
+	}
+	identifyRequestPlayer(ctx, request.GroupName, request.PlayerName)
+	gameState, err := statemanager.SetPlayerLanguage(ctx.Request.Context(), request.GroupName, request.PlayerName, request.Language)
+	if err != nil {
+		abortWithError(ctx, "Error setting language", err)
+		return
+	}
+	respond(ctx, gameState)
+}
+
+// HTTP statuses for each error code, codes that aren't listed are server errors
+var errorCodeStatuses = map[statemanager.ErrorCode]int{
+	statemanager.ErrorCodeInvalidRequest:     http.StatusBadRequest,
+	statemanager.ErrorCodeGameNotFound:       http.StatusNotFound,
+	statemanager.ErrorCodeDrawingNotFound:    http.StatusNotFound,
+	statemanager.ErrorCodeTranscriptNotFound: http.StatusNotFound,
+	statemanager.ErrorCodeNoTimelapse:        http.StatusNotFound,
+	statemanager.ErrorCodePlayerNotInGame:    http.StatusForbidden,
+	statemanager.ErrorCodeNotHost:            http.StatusForbidden,
+	statemanager.ErrorCodeGroupAlreadyExists: http.StatusConflict,
+	statemanager.ErrorCodeHostAlreadyExists:  http.StatusConflict,
+	statemanager.ErrorCodeWrongState:         http.StatusConflict,
+	statemanager.ErrorCodeGamePaused:         http.StatusConflict,
+	statemanager.ErrorCodeAlreadySubmitted:   http.StatusConflict,
+	statemanager.ErrorCodeNotEnoughPlayers:   http.StatusConflict,
+	statemanager.ErrorCodeVotedForOwnDecoy:   http.StatusUnprocessableEntity,
+	statemanager.ErrorCodeInvalidInput:       http.StatusUnprocessableEntity,
+	statemanager.ErrorCodeUnauthorized:       http.StatusUnauthorized,
+}
+
+// Keys for the player a request is made by, used to respond in the language they picked
+const (
+	requestGroupNameKey  = "groupName"
+	requestPlayerNameKey = "playerName"
+)
+
+func identifyRequestPlayer(ctx *gin.Context, groupName string, playerName string) {
+	ctx.Set(requestGroupNameKey, groupName)
+	ctx.Set(requestPlayerNameKey, playerName)
+}
+
+// Players get messages in the language they picked, otherwise the one their browser asks for
+func requestLanguage(ctx *gin.Context) localization.Language {
+	language, found := statemanager.GetPlayerLanguage(ctx.GetString(requestGroupNameKey), ctx.GetString(requestPlayerNameKey))
+	if found {
+		return language
+	}
+	return localization.FromAcceptLanguage(ctx.GetHeader("Accept-Language"))
+}
+
+// Responds with the status and code that match the error, prefixing its message with what was being done
+func abortWithError(ctx *gin.Context, action string, err error) {
+	code := statemanager.ErrorCodeOf(err)
+	status, found := errorCodeStatuses[code]
+	if !found {
+		status = http.StatusInternalServerError
+	}
+	abortWithErrorResponse(ctx, status, formatError(ctx, code, fmt.Sprintf("%s: %s", action, err.Error())))
+}
+
+// Reads a request from the JSON body, or from the route's parameters and query string for GETs,
+// then normalizes and validates it. Version 2 routes read it their own way, see bindResourceRequest.
+func bindRequest(ctx *gin.Context, request interface{}) error {
+	body := limitRequestBody(ctx)
+	var err error
+	if requestAPIVersion(ctx) == 2 {
+		err = bindResourceRequest(ctx, request)
+	} else if ctx.Request.Method == http.MethodGet {
+		err = ctx.ShouldBindUri(request)
+		if err == nil {
+			err = ctx.ShouldBindQuery(request)
+		}
+	} else {
+		err = ctx.ShouldBindJSON(request)
+	}
+	if body.tooLarge {
+		return errRequestTooLarge
+	}
+	if err != nil {
+		return err
+	}
+	return validation.Request(request)
+}
+
+// requestBodyOverhead leaves room for everything in a request besides a drawing's data URL, like its strokes
+const requestBodyOverhead = 1 << 20
+
+var errRequestTooLarge = errors.New("request body is larger than the maximum allowed size")
+
+// limitedBody keeps track of whether a request's body went over its limit,
+// http.MaxBytesReader only says so in its error message before Go 1.19
+type limitedBody struct {
+	io.ReadCloser
+	limit    int64
+	read     int64
+	tooLarge bool
+}
+
+func (body *limitedBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	body.read += int64(n)
+	if err != nil && err != io.EOF && body.read >= body.limit {
+		body.tooLarge = true
+	}
+	return n, err
+}
+
+// Stops reading request bodies that are bigger than the largest drawing players can submit
+func limitRequestBody(ctx *gin.Context) *limitedBody {
+	limit := int64(images.GetDrawingLimits().MaxDataURLLength() + requestBodyOverhead)
+	body := &limitedBody{ReadCloser: http.MaxBytesReader(ctx.Writer, ctx.Request.Body, limit), limit: limit}
+	ctx.Request.Body = body
+	return body
+}
+
+// Responds with the result of a request, version 2 routes wrap it in an envelope
+func respond(ctx *gin.Context, result interface{}) {
+	if requestAPIVersion(ctx) == 2 {
+		respondWithEnvelope(ctx, result)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// Responds with an error, version 2 routes wrap it in an envelope
+func abortWithErrorResponse(ctx *gin.Context, status int, response *errorResponse) {
+	if requestAPIVersion(ctx) == 2 {
+		abortWithErrorEnvelope(ctx, status, response)
+		return
+	}
+	ctx.AbortWithStatusJSON(status, response)
+}
+
+// Requests that can't be read are bad requests, or too large if their body is over the limit. Ones with invalid fields list what's wrong with each of them
+func abortWithInvalidRequest(ctx *gin.Context, err error) {
+	if err == errRequestTooLarge {
+		abortWithErrorResponse(
+			ctx,
+			http.StatusRequestEntityTooLarge,
+			formatError(ctx, statemanager.ErrorCodeInvalidRequest, fmt.Sprintf("Invalid request: %s", err.Error())),
+		)
+		return
+	}
+	fieldErrors, isValidationError := err.(validation.Errors)
+	if !isValidationError {
+		abortWithErrorResponse(
+			ctx,
+			http.StatusBadRequest,
+			formatError(ctx, statemanager.ErrorCodeInvalidRequest, fmt.Sprintf("Invalid request: %s", err.Error())),
+		)
+		return
+	}
+	response := formatError(ctx, statemanager.ErrorCodeInvalidInput, fmt.Sprintf("Invalid request: %s", err.Error()))
+	response.Fields = fieldErrors
+	abortWithErrorResponse(ctx, http.StatusUnprocessableEntity, response)
+}
+
+// Errors have a message in the player's language for showing to them,
+// the English detail of what went wrong is kept for debugging
+type errorResponse struct {
+	Error  string                 `json:"error"`
+	Code   statemanager.ErrorCode `json:"code"`
+	Detail string                 `json:"detail"`
+	// Fields lists what's wrong with each invalid field of a request
+	Fields []*validation.FieldError `json:"fields,omitempty"`
+}
+
+func formatError(ctx *gin.Context, code statemanager.ErrorCode, detail string) *errorResponse {
+	return &errorResponse{
+		Error:  localization.ErrorMessage(requestLanguage(ctx), string(code)),
+		Code:   code,
+		Detail: detail,
+	}
+}
@@ -0,0 +1,140 @@
+//go:build go1.18
+// +build go1.18
+
+package httpapi
+
+import (
+	"bytes"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"drawydraw/test"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The routes fuzzed requests are sent to, every one of them acts on a game
+var fuzzedPostRoutes = []string{
+	"/api/add-player",
+	"/api/add-bot",
+	"/api/create-game",
+	"/api/start-game",
+	"/api/add-prompt",
+	"/api/submit-drawing",
+	"/api/save-draft-drawing",
+	"/api/cast-vote",
+	"/api/pause-game",
+	"/api/resume-game",
+	"/api/rematch",
+	"/api/set-language",
+}
+
+// The games fuzzed requests act on, they're all for the group somegame
+var fuzzedGames = []func() *models.Game{
+	test.GameInWaitingForPlayersState,
+	test.GameInInitialPromptCreationState,
+	test.GameInDrawingsInProgressState,
+	test.GameInDecoyPromptCreationState,
+	test.GameInVotingState,
+	test.GameInScoringState,
+	test.GameInGameOverState,
+}
+
+// FuzzPostRoutes sends arbitrary bodies to the routes that change games, in every state a game can be in
+func FuzzPostRoutes(f *testing.F) {
+	seeds := []string{
+		`{"groupName": "somegame", "playerName": "player1"}`,
+		`{"groupName": "somegame", "playerName": "player2", "noun": "cat", "adjective1": "big", "adjective2": "red"}`,
+		`{"groupName": "somegame", "playerName": "player1", "imageData": "` + test.MockImageData + `"}`,
+		`{"groupName": "somegame", "playerName": "player1", "strokes": [{"color": "#000000", "width": 3, "points": [{"x": 1, "y": 2, "time": 0}]}]}`,
+		`{"groupName": "somegame", "playerName": "player2", "selectedPromptId": "12345"}`,
+		`{"groupName": "somegame", "playerName": "player1", "roundCount": 2, "allowSelfVotes": true}`,
+		`{"groupName": "somegame", "playerName": "player1", "language": "es"}`,
+		`{"groupName": "", "playerName": null}`,
+		`[]`,
+		``,
+	}
+	for state := range fuzzedGames {
+		for route := range fuzzedPostRoutes {
+			f.Add(uint8(state), uint8(route), []byte(seeds[(state+route)%len(seeds)]))
+		}
+	}
+	router := newFuzzRouter()
+	f.Fuzz(func(t *testing.T, state uint8, route uint8, body []byte) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		test.SetupTestArchiveStore(t)
+		models.GetGameProvider().SaveGame(fuzzedGames[int(state)%len(fuzzedGames)]())
+		request := httptest.NewRequest(http.MethodPost, fuzzedPostRoutes[int(route)%len(fuzzedPostRoutes)], bytes.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		checkFuzzedResponse(t, router, request)
+	})
+}
+
+// FuzzGetGameStatus asks for the status of arbitrary groups and players, which end up in the path and the query
+func FuzzGetGameStatus(f *testing.F) {
+	f.Add("somegame", "player1")
+	f.Add("somegame", "stray cat")
+	f.Add("some game", "player1&playerName=player2")
+	f.Add("", "")
+	f.Add("%zz", "\x00")
+	router := newFuzzRouter()
+	f.Fuzz(func(t *testing.T, groupName string, playerName string) {
+		// Paths without a group or with more segments don't match the route
+		if groupName == "" || strings.Contains(groupName, "/") {
+			t.Skip()
+		}
+		test.SetupTestGameProvider(t)
+		models.GetGameProvider().SaveGame(test.GameInVotingState())
+		query := url.Values{"playerName": {playerName}}
+		request := httptest.NewRequest(http.MethodGet, "/api/get-game-status/"+url.PathEscape(groupName)+"?"+query.Encode(), nil)
+		checkFuzzedResponse(t, router, request)
+	})
+}
+
+// FuzzPlayerNameHeader sends arbitrary player names in the header version 2 of the API reads them from
+func FuzzPlayerNameHeader(f *testing.F) {
+	f.Add("player1")
+	f.Add("player%201")
+	f.Add("%E0%A4%A")
+	f.Add("")
+	router := newFuzzRouter()
+	f.Fuzz(func(t *testing.T, playerName string) {
+		test.SetupTestGameProvider(t)
+		models.GetGameProvider().SaveGame(test.GameInVotingState())
+		request := httptest.NewRequest(http.MethodGet, "/api/v2/groups/somegame", nil)
+		request.Header["X-Player-Name"] = []string{playerName}
+		checkFuzzedResponse(t, router, request)
+	})
+}
+
+func newFuzzRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	// Logging every fuzzed request would slow fuzzing down
+	gin.DefaultWriter = ioutil.Discard
+	logging.SetLogger(logging.NewLogger(ioutil.Discard, logging.LevelError))
+	return NewRouter()
+}
+
+// checkFuzzedResponse fails when a request crashes the handler or fails without one of the API's errors
+func checkFuzzedResponse(t *testing.T, router *gin.Engine, request *http.Request) {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	if recorder.Code >= http.StatusInternalServerError {
+		t.Fatalf("%s %s responded %d: %s", request.Method, request.URL, recorder.Code, recorder.Body.String())
+	}
+	if recorder.Code < http.StatusBadRequest {
+		return
+	}
+	body := map[string]json.RawMessage{}
+	err := json.Unmarshal(recorder.Body.Bytes(), &body)
+	if err != nil || body["error"] == nil {
+		t.Fatalf("%s %s responded %d without an error: %s", request.Method, request.URL, recorder.Code, recorder.Body.String())
+	}
+}
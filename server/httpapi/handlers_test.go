@@ -0,0 +1,1066 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"drawydraw/archive"
+	"drawydraw/images"
+	"drawydraw/localization"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"drawydraw/openapi"
+	"drawydraw/statemanager"
+	"drawydraw/test"
+	"drawydraw/validation"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/gif"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// This function is used for setup before executing the test functions
+func TestMain(m *testing.M) {
+	//Set Gin to Test Mode
+	gin.SetMode(gin.TestMode)
+	// Only failures are logged so they aren't lost among the requests
+	logging.SetLogger(logging.NewLogger(os.Stderr, logging.LevelError))
+	// Setup here
+	status := m.Run()
+	// Cleanup here
+	os.Exit(status)
+}
+
+func TestCreateGameRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		// Create the game
+		req := api.request(t, "createGame", "Kitten Party", "Baby Cat", nil)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     "Kitten Party",
+			CurrentPlayer: &statemanager.CurrentPlayer{Name: "Baby Cat", IsHost: true},
+			CurrentState:  string(models.WaitingForPlayers),
+			Players: []*statemanager.Player{
+				{Name: "Baby Cat", Host: true},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestGetGameStateStatusRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		models.GetGameProvider().SaveGame(test.GameInWaitingForPlayersState())
+		req := api.request(t, "getGameStatus", "somegame", "player1", nil)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     "somegame",
+			CurrentPlayer: &statemanager.CurrentPlayer{Name: "player1", IsHost: true},
+			CurrentState:  string(models.WaitingForPlayers),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true},
+				{Name: "player2"},
+				{Name: "player3"},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestCreateGameRoute__GameAlreadyExists(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		models.GetGameProvider().SaveGame(test.GameInWaitingForPlayersState())
+		req := api.request(t, "createGame", "somegame", "some player", nil)
+		sendFailingRequest(t, api, req, http.StatusConflict, statemanager.ErrorCodeGroupAlreadyExists)
+	})
+}
+
+func TestAddPlayerRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		// Add the player
+		req := api.request(t, "addPlayer", game.GroupName, "player4", nil)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     "somegame",
+			CurrentPlayer: &statemanager.CurrentPlayer{Name: "player4"},
+			CurrentState:  string(models.WaitingForPlayers),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true},
+				{Name: "player2"},
+				{Name: "player3"},
+				{Name: "player4"},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestAddPlayerRoute_GroupNotSetup(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		req := api.request(t, "addPlayer", "superGroup", "player", nil)
+		sendFailingRequest(t, api, req, http.StatusNotFound, statemanager.ErrorCodeGameNotFound)
+	})
+}
+
+func TestAddPlayerRoute_NormalizesNames(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "addPlayer", "  "+game.GroupName+" ", "José   Cat ", nil)
+		actualGameState := sendRequest(t, api, req)
+		assert.Equal(t, "José Cat", actualGameState.CurrentPlayer.Name)
+		assert.True(t, game.IsPlayerInGame("José Cat"))
+	})
+}
+
+func TestAddPlayerRoute_InvalidFields(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		models.GetGameProvider().SaveGame(test.GameInWaitingForPlayersState())
+		req := api.request(t, "addPlayer", "<cats>", "", nil)
+		response := sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		assert.Equal(t, []*validation.FieldError{
+			{Field: "playerName", Rule: "required", Message: "is required"},
+			{Field: "groupName", Rule: "name", Message: "can only contain letters, numbers, spaces and - ' . _"},
+		}, response.Fields)
+
+		req = api.request(t, "addPlayer", "somegame", "a cat with a really really long name", nil)
+		response = sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		assert.Equal(t, []*validation.FieldError{
+			{Field: "playerName", Rule: "max", Message: "must be at most 30 characters long"},
+		}, response.Fields)
+	})
+}
+
+func TestAddPromptRoute_InvalidFields(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInInitialPromptCreationState()
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{
+			"noun":       "   ",
+			"adjective1": "b1g",
+			"adjective2": "stinky",
+		}
+		req := api.request(t, "addPrompt", game.GroupName, "player1", data)
+		response := sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		assert.Equal(t, []*validation.FieldError{
+			{Field: "noun", Rule: "required", Message: "is required"},
+			{Field: "adjective1", Rule: "word", Message: "can only contain letters, spaces, hyphens and apostrophes"},
+		}, response.Fields)
+		assert.Empty(t, game.OriginalPrompts)
+	})
+}
+
+func TestStartGameRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "startGame", game.GroupName, "player1", nil)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{IsHost: true, Name: "player1"},
+			CurrentState:  string(models.InitialPromptCreation),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true, HasPendingAction: true},
+				{Name: "player2", HasPendingAction: true},
+				{Name: "player3", HasPendingAction: true},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestStartGameRoute_NonHost(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "startGame", game.GroupName, "player2", nil)
+		sendFailingRequest(t, api, req, http.StatusForbidden, statemanager.ErrorCodeNotHost)
+	})
+}
+
+func TestStartGameRoute_InvalidRequest(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		req := api.request(t, "startGame", "somegame", "player1", "not a request")
+		sendFailingRequest(t, api, req, http.StatusBadRequest, statemanager.ErrorCodeInvalidRequest)
+	})
+}
+
+func TestAddBotRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "addBot", game.GroupName, "player1", nil)
+		gameStatus := sendRequest(t, api, req)
+		assert.Len(t, gameStatus.Players, 4)
+		assert.True(t, gameStatus.Players[3].Bot)
+
+		req = api.request(t, "addBot", game.GroupName, "player2", nil)
+		sendFailingRequest(t, api, req, http.StatusForbidden, statemanager.ErrorCodeNotHost)
+	})
+}
+
+func TestAddPromptRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInInitialPromptCreationState()
+		models.GetGameProvider().SaveGame(game)
+		//Make a post to the add prompts route from player 1, confirm state stays at "Initial Prompt Creation"
+		data := map[string]interface{}{
+			"noun":       "chicken",
+			"adjective1": "snazzy",
+			"adjective2": "portly",
+		}
+		req := api.request(t, "addPrompt", game.GroupName, "player1", data)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName: game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{
+				IsHost:             true,
+				Name:               "player1",
+				HasCompletedAction: true,
+			},
+			CurrentState: string(models.InitialPromptCreation),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true, HasPendingAction: false},
+				{Name: "player2", HasPendingAction: true},
+				{Name: "player3", HasPendingAction: true},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestAddPromptRoute_AssignsPrompts(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInInitialPromptCreationState()
+		// Add the prompt from player 1
+		game.OriginalPrompts = []*models.Prompt{
+			models.BuildPrompt("chicken", []string{"snazzy", "portly"}, "player1"),
+			models.BuildPrompt("tuna", []string{"big", "majestic"}, "player2"),
+		}
+		models.GetGameProvider().SaveGame(game)
+		//Make a post to the add prompts route from player 2, state should transition to drawings in progress
+		data := map[string]interface{}{
+			"noun":       "orangutan",
+			"adjective1": "fiery",
+			"adjective2": "friendly",
+		}
+		req := api.request(t, "addPrompt", game.GroupName, "player3", data)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName: game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{
+				Name: "player3",
+				AssignedPrompt: &statemanager.Prompt{
+					Noun:       "chicken",
+					Adjectives: []string{"snazzy", "portly"},
+				},
+			},
+			CurrentState: string(models.DrawingsInProgress),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true, HasPendingAction: true},
+				{Name: "player2", HasPendingAction: true},
+				{Name: "player3", HasPendingAction: true},
+			},
+		}
+		// Since prompt assignment is random, check that the adjectives assigned are in the original list
+		allAdjectives := map[string]bool{"snazzy": true, "portly": true, "fiery": true, "friendly": true, "big": true, "majestic": true}
+		for _, adjective := range actualGameState.CurrentPlayer.AssignedPrompt.Adjectives {
+			assert.True(t, allAdjectives[adjective])
+		}
+		// The same adjective should not be picked twice
+		assert.NotEqual(
+			t,
+			actualGameState.CurrentPlayer.AssignedPrompt.Adjectives[0],
+			actualGameState.CurrentPlayer.AssignedPrompt.Adjectives[1],
+		)
+		// Since we checked the adjectives let's just ignore those in the comparison with the expected state
+		expectedGameState.CurrentPlayer.AssignedPrompt.Adjectives = actualGameState.CurrentPlayer.AssignedPrompt.Adjectives
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestSubmitDrawingRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		// Submit a drawing
+		data := map[string]interface{}{"imageData": test.MockImageData}
+		req := api.request(t, "submitDrawing", game.GroupName, "player1", data)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName: game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{
+				IsHost:             true,
+				Name:               "player1",
+				HasCompletedAction: true,
+				AssignedPrompt: &statemanager.Prompt{
+					Noun:       "boat",
+					Adjectives: []string{"elegant", "sharp"},
+				},
+			},
+			CurrentState: string(models.DrawingsInProgress),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true, HasPendingAction: false},
+				{Name: "player2", HasPendingAction: true},
+				{Name: "player3", HasPendingAction: true},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestSubmitDrawingRoute_Strokes(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{
+			"strokes": []map[string]interface{}{
+				{"color": "#ff0000", "width": 4, "points": []map[string]interface{}{{"x": 1, "y": 2, "time": 0}, {"x": 30, "y": 40, "time": 16}}},
+			},
+		}
+		req := api.request(t, "submitDrawing", game.GroupName, "player1", data)
+		actualGameState := sendRequest(t, api, req)
+		assert.True(t, actualGameState.CurrentPlayer.HasCompletedAction)
+		assert.Len(t, game.Drawings[0].Strokes, 1)
+		assert.Equal(t, []models.StrokePoint{{X: 1, Y: 2, Time: 0}, {X: 30, Y: 40, Time: 16}}, game.Drawings[0].Strokes[0].Points)
+	})
+}
+
+func TestSaveDraftDrawingRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{"imageData": test.MockImageData}
+		req := api.request(t, "saveDraftDrawing", game.GroupName, "player1", data)
+		actualGameState := sendRequest(t, api, req)
+		assert.False(t, actualGameState.CurrentPlayer.HasCompletedAction)
+		assert.NotEmpty(t, actualGameState.CurrentPlayer.DraftDrawing.ImageURL)
+		assert.Empty(t, game.Drawings)
+	})
+}
+
+func TestSubmitDrawingRoute_AlreadySubmitted(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{"imageData": test.MockImageData}
+		sendRequest(t, api, api.request(t, "submitDrawing", game.GroupName, "player1", data))
+		req := api.request(t, "submitDrawing", game.GroupName, "player1", data)
+		sendFailingRequest(t, api, req, http.StatusConflict, statemanager.ErrorCodeAlreadySubmitted)
+		req = api.request(t, "submitDrawing", game.GroupName, "stray cat", data)
+		sendFailingRequest(t, api, req, http.StatusForbidden, statemanager.ErrorCodePlayerNotInGame)
+	})
+}
+
+func TestSubmitDrawingRoute_InvalidImage(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{"imageData": "someImageData"}
+		req := api.request(t, "submitDrawing", game.GroupName, "player1", data)
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		assert.Empty(t, game.Drawings)
+	})
+}
+
+func TestSubmitDrawingRoute_BodyTooLarge(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestDrawingStore(t)
+		previousLimits := images.GetDrawingLimits()
+		images.SetDrawingLimits(images.DrawingLimits{MaxBytes: 1 << 10, MaxWidth: 100, MaxHeight: 100})
+		t.Cleanup(func() {
+			images.SetDrawingLimits(previousLimits)
+		})
+		game := test.GameInDrawingsInProgressState()
+		models.GetGameProvider().SaveGame(game)
+		// The body stops being read once it's over the limit, long before the data URL is validated
+		data := map[string]interface{}{"imageData": strings.Repeat("a", 2<<20)}
+		req := api.request(t, "submitDrawing", game.GroupName, "player1", data)
+		sendFailingRequest(t, api, req, http.StatusRequestEntityTooLarge, statemanager.ErrorCodeInvalidRequest)
+		assert.Empty(t, game.Drawings)
+	})
+}
+
+func TestGetDrawingTimelapseRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInScoringState()
+		game.Drawings[0].Strokes = []*models.Stroke{
+			{Color: "#ff0000", Width: 4, Points: []models.StrokePoint{{X: 1, Y: 2, Time: 0}, {X: 30, Y: 40, Time: 1000}}},
+		}
+		models.GetGameProvider().SaveGame(game)
+		data := map[string]interface{}{"author": game.Drawings[0].Author, "frameRate": 5, "maxSeconds": 10}
+		w := serveRequest(api.request(t, "getDrawingTimelapse", game.GroupName, "", data))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/gif", w.Header().Get("Content-Type"))
+		animation, err := gif.DecodeAll(w.Body)
+		assert.Nil(t, err)
+		assert.Len(t, animation.Image, 5)
+		// Drawings that were submitted as images can't be replayed
+		data = map[string]interface{}{"author": game.Drawings[1].Author}
+		req := api.request(t, "getDrawingTimelapse", game.GroupName, "", data)
+		sendFailingRequest(t, api, req, http.StatusNotFound, statemanager.ErrorCodeNoTimelapse)
+		data = map[string]interface{}{"author": game.Drawings[0].Author, "frameRate": 1000}
+		req = api.request(t, "getDrawingTimelapse", game.GroupName, "", data)
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		data = map[string]interface{}{"author": game.Drawings[0].Author, "frameRate": "fast"}
+		req = api.request(t, "getDrawingTimelapse", game.GroupName, "", data)
+		sendFailingRequest(t, api, req, http.StatusBadRequest, statemanager.ErrorCodeInvalidRequest)
+	})
+}
+
+func TestGetDrawingImageRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestDrawingStore(t)
+		imageData := []byte("some image")
+		imageID, _ := images.GetDrawingStore().SaveDrawing(&images.StoredDrawing{MediaType: "image/png", Data: imageData})
+		w := serveRequest(api.request(t, "getDrawingImage", "", "", map[string]interface{}{"imageId": imageID}))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, imageData, w.Body.Bytes())
+		etag := w.Header().Get("ETag")
+		assert.Equal(t, `"`+imageID+`"`, etag)
+		// Clients that already have the image don't get it again
+		req := api.request(t, "getDrawingImage", "", "", map[string]interface{}{"imageId": imageID})
+		req.Header.Set("If-None-Match", etag)
+		w = serveRequest(req)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.Bytes())
+
+		// Missing images aren't cached even when the client sends a matching ETag
+		missingImageID := images.DrawingID([]byte("missing"))
+		req = api.request(t, "getDrawingImage", "", "", map[string]interface{}{"imageId": missingImageID})
+		req.Header.Set("If-None-Match", `"`+missingImageID+`"`)
+		w = serveRequest(req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("Cache-Control"))
+		assert.Empty(t, w.Header().Get("ETag"))
+
+		req = api.request(t, "getDrawingImage", "", "", map[string]interface{}{"imageId": "not-an-image"})
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+	})
+}
+
+func TestCastVoteRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInVotingState()
+		models.GetGameProvider().SaveGame(game)
+		// Cast a vote
+		data := map[string]interface{}{"selectedPromptId": "7876445554424581103"}
+		req := api.request(t, "castVote", game.GroupName, "player1", data)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName: game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{
+				IsHost:             true,
+				Name:               "player1",
+				HasCompletedAction: true,
+			},
+			CurrentState: string(models.Voting),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true, HasPendingAction: false},
+				{Name: "player2", HasPendingAction: false},
+				{Name: "player3", HasPendingAction: true},
+			},
+			CurrentDrawing: &statemanager.Drawing{
+				ImageURL: "/api/images/" + game.Drawings[0].ImageID,
+				Prompts: []*statemanager.Prompt{
+					// Player 1's own decoy is not one of their options
+					{Identifier: "2289583145965790902", Noun: "birb", Adjectives: []string{"jumpy", "edgy"}},
+					{Identifier: "7876445554424581103", Noun: "chicken", Adjectives: []string{"snazzy", "portly"}},
+				},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestPauseGameRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "pauseGame", game.GroupName, "player1", map[string]interface{}{"reason": "Pizza is here"})
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{Name: "player1", IsHost: true},
+			CurrentState:  string(models.WaitingForPlayers),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true},
+				{Name: "player2"},
+				{Name: "player3"},
+			},
+			Paused:      true,
+			PauseReason: "Pizza is here",
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+		// Starting the game is blocked until the host resumes it
+		req = api.request(t, "startGame", game.GroupName, "player1", nil)
+		sendFailingRequest(t, api, req, http.StatusConflict, statemanager.ErrorCodeGamePaused)
+		req = api.request(t, "resumeGame", game.GroupName, "player1", nil)
+		actualGameState = sendRequest(t, api, req)
+		assert.False(t, actualGameState.Paused)
+	})
+}
+
+func TestRematchRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		test.SetupTestArchiveStore(t)
+		game := test.GameInGameOverState()
+		models.GetGameProvider().SaveGame(game)
+		req := api.request(t, "rematch", game.GroupName, "player1", nil)
+		actualGameState := sendRequest(t, api, req)
+		expectedGameState := &statemanager.GameStatusResponse{
+			GroupName:     game.GroupName,
+			CurrentPlayer: &statemanager.CurrentPlayer{Name: "player1", IsHost: true},
+			CurrentState:  string(models.WaitingForPlayers),
+			Players: []*statemanager.Player{
+				{Name: "player1", Host: true},
+				{Name: "player2"},
+				{Name: "player3"},
+			},
+		}
+		assert.EqualValues(t, expectedGameState, actualGameState)
+	})
+}
+
+func TestGetGameHistoryRoutes(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestArchiveStore(t)
+		transcript := &archive.Transcript{
+			GameID:    "1234",
+			GroupName: "somegame",
+			StartedAt: time.Date(2020, 5, 1, 20, 0, 0, 0, time.UTC),
+			Players:   []*archive.PlayerScore{{Name: "player1", Points: 3}},
+			Rounds:    []*archive.Round{{Number: 1, Drawings: []*archive.Drawing{}}},
+		}
+		archive.GetStore().SaveTranscript(transcript)
+		history := &gameHistoryResponse{}
+		sendRequestFor(t, api, api.request(t, "getGameHistory", "somegame", "", nil), history)
+		assert.EqualValues(t, []*archive.Summary{transcript.Summarize()}, history.Games)
+
+		actualTranscript := &archive.Transcript{}
+		req := api.request(t, "getGameTranscript", "somegame", "", map[string]interface{}{"gameId": "1234"})
+		sendRequestFor(t, api, req, actualTranscript)
+		assert.EqualValues(t, transcript, actualTranscript)
+
+		req = api.request(t, "getGameTranscript", "somegame", "", map[string]interface{}{"gameId": "5678"})
+		sendFailingRequest(t, api, req, http.StatusNotFound, statemanager.ErrorCodeTranscriptNotFound)
+
+		req = api.request(t, "getGameTranscript", "somegame", "", map[string]interface{}{"gameId": "12.34"})
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+		req = api.request(t, "getGameHistory", strings.Repeat("a", 31), "", nil)
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+	})
+}
+
+func TestExportGameRoute(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestArchiveStore(t)
+		archive.GetStore().SaveTranscript(&archive.Transcript{GameID: "1234", GroupName: "somegame"})
+		w := serveRequest(api.request(t, "exportGame", "somegame", "", map[string]interface{}{"gameId": "1234"}))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="drawydraw-1234.zip"`, w.Header().Get("Content-Disposition"))
+
+		req := api.request(t, "exportGame", "somegame", "", map[string]interface{}{"gameId": "5678"})
+		sendFailingRequest(t, api, req, http.StatusNotFound, statemanager.ErrorCodeTranscriptNotFound)
+
+		req = api.request(t, "exportGame", "some!game", "", map[string]interface{}{"gameId": "1234"})
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+	})
+}
+
+func TestErrors_AreLocalized(t *testing.T) {
+	forEachAPIVersion(t, func(t *testing.T, api testAPI) {
+		test.SetupTestGameProvider(t)
+		game := test.GameInWaitingForPlayersState()
+		models.GetGameProvider().SaveGame(game)
+		// The browser's language is used until the player picks one
+		req := api.request(t, "startGame", game.GroupName, "player2", nil)
+		req.Header.Set("Accept-Language", "es-MX,es;q=0.9,en;q=0.8")
+		response := sendFailingRequest(t, api, req, http.StatusForbidden, statemanager.ErrorCodeNotHost)
+		assert.Equal(t, "Solo el anfitrión puede hacer eso", response.Error)
+		assert.Equal(t, "Error starting game: only the host can start a game", response.Detail)
+
+		req = api.request(t, "setLanguage", game.GroupName, "player2", map[string]interface{}{"language": "en"})
+		actualGameState := sendRequest(t, api, req)
+		assert.Equal(t, "en", actualGameState.CurrentPlayer.Language)
+		req = api.request(t, "startGame", game.GroupName, "player2", nil)
+		req.Header.Set("Accept-Language", "es")
+		response = sendFailingRequest(t, api, req, http.StatusForbidden, statemanager.ErrorCodeNotHost)
+		assert.Equal(t, "Only the host can do that", response.Error)
+
+		req = api.request(t, "setLanguage", game.GroupName, "player2", map[string]interface{}{"language": "klingon"})
+		sendFailingRequest(t, api, req, http.StatusUnprocessableEntity, statemanager.ErrorCodeInvalidInput)
+	})
+}
+
+func TestErrorCodes_HaveMessages(t *testing.T) {
+	assert.True(t, localization.HasErrorMessage(string(statemanager.ErrorCodeInternal)))
+	for code := range errorCodeStatuses {
+		assert.True(t, localization.HasErrorMessage(string(code)), code)
+	}
+}
+
+func TestV2Routes_IdentifyGroupAndPlayer(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	api := testAPI{version: 2}
+	// The route picks the group even when the body names another one
+	req := api.request(t, "addPlayer", game.GroupName, "player4", map[string]interface{}{"groupName": "othergame"})
+	actualGameState := sendRequest(t, api, req)
+	assert.Equal(t, game.GroupName, actualGameState.GroupName)
+	assert.True(t, game.IsPlayerInGame("player4"))
+
+	req = api.request(t, "addPlayer", game.GroupName, "", nil)
+	req.Header.Set(playerNameHeader, "%zz")
+	sendFailingRequest(t, api, req, http.StatusBadRequest, statemanager.ErrorCodeInvalidRequest)
+}
+
+// Admin requests are authorized with the token that's set up for the test
+func setupTestAdminToken(t *testing.T) string {
+	previousToken := adminToken
+	adminToken = "test admin token"
+	t.Cleanup(func() {
+		adminToken = previousToken
+	})
+	return adminToken
+}
+
+func createAdminRequest(t *testing.T, method string, route string, token string) *http.Request {
+	req, err := http.NewRequest(method, route, nil)
+	assert.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestAdminRoutes_WrongToken_Unauthorized(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	setupTestAdminToken(t)
+	for _, token := range []string{"", "wrong token"} {
+		w := serveRequest(createAdminRequest(t, "GET", "/admin/games", token))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), string(statemanager.ErrorCodeUnauthorized))
+	}
+}
+
+func TestAdminRoutes_TokenWithoutBearerScheme_Unauthorized(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	for _, authorization := range []string{token, "Basic " + token, "bearer" + token} {
+		req, err := http.NewRequest("GET", "/admin/games", nil)
+		assert.Nil(t, err)
+		req.Header.Set("Authorization", authorization)
+		w := serveRequest(req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, authorization)
+	}
+}
+
+func TestAdminRoutes_NoTokenConfigured_Unauthorized(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	w := serveRequest(createAdminRequest(t, "GET", "/admin/games", ""))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAdminListGames(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	models.GetGameProvider().SaveGame(test.GameInVotingState())
+	statemanager.CreateGroup(context.Background(), "cats", models.GameSettings{})
+	w := serveRequest(createAdminRequest(t, "GET", "/admin/games", token))
+	assert.Equal(t, http.StatusOK, w.Code)
+	response := &adminGameListResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), response)
+	assert.Nil(t, err)
+	assert.Len(t, response.Games, 2)
+	assert.Equal(t, "cats", response.Games[0].GroupName)
+	assert.EqualValues(t, models.WaitingForPlayers, response.Games[0].CurrentState)
+	assert.Equal(t, "somegame", response.Games[1].GroupName)
+	assert.EqualValues(t, models.Voting, response.Games[1].CurrentState)
+	assert.Equal(t, 3, response.Games[1].PlayerCount)
+}
+
+func TestAdminGetGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	w := serveRequest(createAdminRequest(t, "GET", "/admin/games/"+game.GroupName, token))
+	assert.Equal(t, http.StatusOK, w.Code)
+	response := &models.Game{}
+	err := json.Unmarshal(w.Body.Bytes(), response)
+	assert.Nil(t, err)
+	assert.Equal(t, game.GroupName, response.GroupName)
+	assert.Len(t, response.Drawings, len(game.Drawings))
+
+	w = serveRequest(createAdminRequest(t, "GET", "/admin/games/missing", token))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestAdminAdvanceGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	w := serveRequest(createAdminRequest(t, "POST", "/admin/games/"+game.GroupName+"/advance", token))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.EqualValues(t, models.Scoring, models.GetGameProvider().LoadGame(game.GroupName).CurrentState)
+
+	models.GetGameProvider().SaveGame(test.GameInInitialPromptCreationState())
+	w = serveRequest(createAdminRequest(t, "POST", "/admin/games/"+game.GroupName+"/advance", token))
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestAdminResetGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	w := serveRequest(createAdminRequest(t, "POST", "/admin/games/"+game.GroupName+"/reset", token))
+	assert.Equal(t, http.StatusOK, w.Code)
+	resetGame := models.GetGameProvider().LoadGame(game.GroupName)
+	assert.EqualValues(t, models.WaitingForPlayers, resetGame.CurrentState)
+	assert.Len(t, resetGame.Players, len(game.Players))
+}
+
+func TestAdminDeleteGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	token := setupTestAdminToken(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	w := serveRequest(createAdminRequest(t, "DELETE", "/admin/games/"+game.GroupName, token))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Nil(t, models.GetGameProvider().LoadGame(game.GroupName))
+
+	w = serveRequest(createAdminRequest(t, "DELETE", "/admin/games/"+game.GroupName, token))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestAPIDocument_MatchesRoutes(t *testing.T) {
+	document := newAPIDocument()
+	routes := NewRouter().Routes()
+	routePaths := map[string]bool{}
+	for _, route := range routes {
+		path := openapi.OpenAPIPath(route.Path)
+		method := strings.ToLower(route.Method)
+		routePaths[method+" "+path] = true
+		operation, found := document.Paths[path][method]
+		if !assert.True(t, found, "%s %s is not documented", route.Method, route.Path) {
+			continue
+		}
+		for _, name := range openapi.PathParameters(route.Path) {
+			documented := false
+			for _, parameter := range operation.Parameters {
+				documented = documented || (parameter.In == "path" && parameter.Name == name)
+			}
+			assert.True(t, documented, "%s %s does not document its %s parameter", route.Method, route.Path, name)
+		}
+	}
+	for path, pathItem := range document.Paths {
+		for method := range pathItem {
+			assert.True(t, routePaths[method+" "+path], "%s %s is documented but not routed", method, path)
+		}
+	}
+}
+
+func TestMetricsRoute(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	router := NewRouter()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, createRequest(t, "POST", "/api/create-game", map[string]string{"groupName": "Kitten Party", "playerName": "Baby Cat"}))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, createRequest(t, "GET", "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `drawydraw_http_requests_total{method="POST",route="/api/create-game",status="200"}`)
+	assert.Contains(t, body, `drawydraw_statemanager_operations_total{code="OK",operation="CreateGroup"}`)
+	assert.Contains(t, body, `drawydraw_games{state="WaitingForPlayers"} 1`)
+}
+
+func TestRequestLogging_UsesRequestID(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	logOutput := test.SetupTestLogger(t, logging.LevelInfo)
+	req := createRequest(t, "POST", "/api/create-game", map[string]string{"groupName": "Kitten Party", "playerName": "Baby Cat"})
+	req.Header.Set(requestIDHeader, "request-1")
+	w := serveRequest(req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "request-1", w.Header().Get(requestIDHeader))
+	lines := strings.Split(strings.TrimSpace(logOutput.String()), "\n")
+	requestLine := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[len(lines)-1]), &requestLine))
+	assert.Equal(t, "HTTP request", requestLine["message"])
+	assert.Equal(t, "request-1", requestLine["requestId"])
+	assert.Equal(t, "/api/create-game", requestLine["route"])
+	assert.Equal(t, 200.0, requestLine["status"])
+	for _, line := range lines {
+		assert.Contains(t, line, `"requestId":"request-1"`)
+	}
+}
+
+func TestRequestLogging_MalformedRequestID_Replaced(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestLogger(t, logging.LevelInfo)
+	req := createRequest(t, "GET", "/api/get-game-status/missing", nil)
+	req.Header.Set(requestIDHeader, "not a valid id")
+	w := serveRequest(req)
+	assert.NotEqual(t, "not a valid id", w.Header().Get(requestIDHeader))
+	assert.NotEmpty(t, w.Header().Get(requestIDHeader))
+}
+
+func TestHealthRoute(t *testing.T) {
+	w := serveRequest(createRequest(t, "GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
+
+func TestReadinessRoute_ChecksGameProvider(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	w := serveRequest(createRequest(t, "GET", "/readyz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	models.GetGameProvider().(*test.TestGameProvider).SetHealthError(errors.New("database is down"))
+	w = serveRequest(createRequest(t, "GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "database is down")
+}
+
+func TestReadinessRoute_ShuttingDown_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	SetShuttingDown()
+	t.Cleanup(func() {
+		atomic.StoreInt32(&shuttingDown, 0)
+	})
+	w := serveRequest(createRequest(t, "GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	w = serveRequest(createRequest(t, "GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetAPIDocumentRoute(t *testing.T) {
+	req := createRequest(t, "GET", "/api/openapi.json", nil)
+	w := serveRequest(req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	document := struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]*openapi.Schema `json:"schemas"`
+		} `json:"components"`
+	}{}
+	err := json.Unmarshal(w.Body.Bytes(), &document)
+	assert.Nil(t, err)
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	for _, name := range []string{"GameStatusResponse", "CurrentPlayer", "Drawing", "PointStanding", "PointsBreakdown", "ErrorResponse", "ErrorEnvelope"} {
+		assert.Contains(t, document.Components.Schemas, name)
+	}
+	errorSchema := document.Components.Schemas["ErrorResponse"]
+	assert.Contains(t, errorSchema.Properties["code"].Enum, string(statemanager.ErrorCodeNotHost))
+	assert.Equal(t, "#/components/schemas/FieldError", errorSchema.Properties["fields"].Items.Ref)
+}
+
+// Handler tests run against every version of the API, which have their own routes for the same operations
+type testAPI struct {
+	version int
+}
+
+func forEachAPIVersion(t *testing.T, run func(t *testing.T, api testAPI)) {
+	for _, api := range []testAPI{{version: 1}, {version: 2}} {
+		t.Run(fmt.Sprintf("v%d", api.version), func(t *testing.T) {
+			run(t, api)
+		})
+	}
+}
+
+type testRoute struct {
+	method string
+	path   string
+}
+
+// Routes of each operation by API version, the {placeholders} in them are filled in from the request
+var testRoutes = map[string]map[int]testRoute{
+	"createGame":          {1: {"POST", "/api/create-game"}, 2: {"POST", "/api/v2/groups"}},
+	"getGameStatus":       {1: {"GET", "/api/get-game-status/{groupName}"}, 2: {"GET", "/api/v2/groups/{groupName}"}},
+	"addPlayer":           {1: {"POST", "/api/add-player"}, 2: {"POST", "/api/v2/groups/{groupName}/players"}},
+	"setLanguage":         {1: {"POST", "/api/set-language"}, 2: {"PUT", "/api/v2/groups/{groupName}/players/me/language"}},
+	"startGame":           {1: {"POST", "/api/start-game"}, 2: {"POST", "/api/v2/groups/{groupName}/rounds"}},
+	"addBot":              {1: {"POST", "/api/add-bot"}, 2: {"POST", "/api/v2/groups/{groupName}/bots"}},
+	"addPrompt":           {1: {"POST", "/api/add-prompt"}, 2: {"POST", "/api/v2/groups/{groupName}/prompts"}},
+	"submitDrawing":       {1: {"POST", "/api/submit-drawing"}, 2: {"POST", "/api/v2/groups/{groupName}/drawings"}},
+	"saveDraftDrawing":    {1: {"POST", "/api/save-draft-drawing"}, 2: {"PUT", "/api/v2/groups/{groupName}/players/me/draft-drawing"}},
+	"castVote":            {1: {"POST", "/api/cast-vote"}, 2: {"POST", "/api/v2/groups/{groupName}/rounds/current/votes"}},
+	"pauseGame":           {1: {"POST", "/api/pause-game"}, 2: {"PUT", "/api/v2/groups/{groupName}/pause"}},
+	"resumeGame":          {1: {"POST", "/api/resume-game"}, 2: {"DELETE", "/api/v2/groups/{groupName}/pause"}},
+	"rematch":             {1: {"POST", "/api/rematch"}, 2: {"POST", "/api/v2/groups/{groupName}/games"}},
+	"getGameHistory":      {1: {"GET", "/api/get-game-history/{groupName}"}, 2: {"GET", "/api/v2/groups/{groupName}/games"}},
+	"getGameTranscript":   {1: {"GET", "/api/get-game-transcript/{groupName}/{gameId}"}, 2: {"GET", "/api/v2/groups/{groupName}/games/{gameId}"}},
+	"exportGame":          {1: {"GET", "/api/export-game/{groupName}/{gameId}"}, 2: {"GET", "/api/v2/groups/{groupName}/games/{gameId}/gallery"}},
+	"getDrawingImage":     {1: {"GET", "/api/images/{imageId}"}, 2: {"GET", "/api/v2/images/{imageId}"}},
+	"getDrawingTimelapse": {1: {"GET", "/api/get-drawing-timelapse/{groupName}"}, 2: {"GET", "/api/v2/groups/{groupName}/drawings/{author}/timelapse"}},
+}
+
+// Builds the request for an operation made by a player of a group, leave the player empty for operations anyone can make.
+// The data is the rest of the request: fields that aren't in the route go in the body, or the query string for GETs,
+// and data that isn't a map is sent as the body as it is.
+func (api testAPI) request(t *testing.T, operation string, groupName string, playerName string, data interface{}) *http.Request {
+	route := testRoutes[operation][api.version]
+	fields := map[string]interface{}{}
+	dataFields, isMap := data.(map[string]interface{})
+	for name, value := range dataFields {
+		fields[name] = value
+	}
+	if groupName != "" {
+		fields["groupName"] = groupName
+	}
+	// Version 2 identifies the player with a header instead
+	if api.version == 1 && playerName != "" {
+		fields["playerName"] = playerName
+	}
+	path := route.path
+	for name, value := range fields {
+		placeholder := "{" + name + "}"
+		if strings.Contains(path, placeholder) {
+			path = strings.Replace(path, placeholder, url.PathEscape(fmt.Sprint(value)), 1)
+			delete(fields, name)
+		}
+	}
+	// A group named in the data stays in the body even when the route has one
+	if dataGroupName, found := dataFields["groupName"]; found {
+		fields["groupName"] = dataGroupName
+	}
+
+	var req *http.Request
+	if route.method == http.MethodGet {
+		query := url.Values{}
+		for name, value := range fields {
+			query.Set(name, fmt.Sprint(value))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+		req = createRequest(t, route.method, path, nil)
+	} else if !isMap && data != nil {
+		req = createRequest(t, route.method, path, data)
+	} else if api.version == 2 && len(fields) == 0 {
+		// Version 2 requests that only need the route don't have a body
+		emptyRequest, err := http.NewRequest(route.method, path, nil)
+		assert.Nil(t, err)
+		req = emptyRequest
+	} else {
+		req = createRequest(t, route.method, path, fields)
+	}
+	if api.version == 2 && playerName != "" {
+		req.Header.Set(playerNameHeader, url.PathEscape(playerName))
+	}
+	return req
+}
+
+// Helper function to process a request and get the game status it responds with
+func sendRequest(t *testing.T, api testAPI, req *http.Request) *statemanager.GameStatusResponse {
+	actualGameState := &statemanager.GameStatusResponse{}
+	sendRequestFor(t, api, req, actualGameState)
+	return actualGameState
+}
+
+// Helper function to process a request that should succeed and read what it responds with.
+// Version 2 responds with 201 Created when something is added and wraps results in an envelope.
+func sendRequestFor(t *testing.T, api testAPI, req *http.Request, result interface{}) {
+	w := serveRequest(req)
+	if api.version == 1 {
+		assert.Equal(t, http.StatusOK, w.Code)
+		json.Unmarshal(w.Body.Bytes(), result)
+		return
+	}
+	if req.Method == http.MethodPost {
+		assert.Equal(t, http.StatusCreated, w.Code)
+	} else {
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+	json.Unmarshal(w.Body.Bytes(), &resultEnvelope{Data: result})
+}
+
+// Helper function to process a request that should fail and test the error it responds with
+func sendFailingRequest(t *testing.T, api testAPI, req *http.Request, statusCode int, errorCode statemanager.ErrorCode) *errorResponse {
+	w := serveRequest(req)
+	assert.Equal(t, statusCode, w.Code)
+	response := &errorResponse{}
+	if api.version == 1 {
+		err := json.Unmarshal(w.Body.Bytes(), response)
+		assert.Nil(t, err)
+	} else {
+		envelope := &errorEnvelope{}
+		err := json.Unmarshal(w.Body.Bytes(), envelope)
+		if assert.Nil(t, err) && assert.NotNil(t, envelope.Error) {
+			response = &errorResponse{
+				Error:  envelope.Error.Message,
+				Code:   envelope.Error.Code,
+				Detail: envelope.Error.Detail,
+				Fields: envelope.Error.Fields,
+			}
+		}
+	}
+	assert.Equal(t, errorCode, response.Code)
+	assert.NotEmpty(t, response.Error)
+	return response
+}
+
+// Helper function to process a request and get the raw response
+func serveRequest(req *http.Request) *httptest.ResponseRecorder {
+	// Create a response recorder// Test set up
+	w := httptest.NewRecorder()
+
+	// Create the service and process the above request.
+	r := NewRouter()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func createRequest(t *testing.T, method string, route string, data interface{}) *http.Request {
+	jsonData, err := json.Marshal(&data)
+	assert.Nil(t, err)
+	req, err := http.NewRequest(method, route, bytes.NewBuffer(jsonData))
+	assert.Nil(t, err)
+	req.Header.Add("Content-Type", "application/json")
+	return req
+}
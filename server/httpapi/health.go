@@ -0,0 +1,48 @@
+package httpapi
+
+import (
+	"context"
+	"drawydraw/models"
+	"drawydraw/statemanager"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// How long the game provider has to answer a readiness check
+const readinessTimeout = 2 * time.Second
+
+// Set once the server starts shutting down, so load balancers stop sending it requests while it finishes the ones it has
+var shuttingDown int32
+
+// SetShuttingDown makes readiness checks fail from now on, the server keeps serving requests until it's stopped
+func SetShuttingDown() {
+	atomic.StoreInt32(&shuttingDown, 1)
+}
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+// The server is healthy as long as it answers
+func checkHealth(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// The server is ready for requests when it isn't shutting down and the game provider can be reached
+func checkReadiness(ctx *gin.Context) {
+	if atomic.LoadInt32(&shuttingDown) == 1 {
+		abortWithErrorResponse(ctx, http.StatusServiceUnavailable, formatError(ctx, statemanager.ErrorCodeInternal, "the server is shutting down"))
+		return
+	}
+	checkContext, cancel := context.WithTimeout(ctx.Request.Context(), readinessTimeout)
+	defer cancel()
+	err := models.CheckGameProvider(checkContext, models.GetGameProvider())
+	if err != nil {
+		abortWithErrorResponse(ctx, http.StatusServiceUnavailable, formatError(ctx, statemanager.ErrorCodeInternal, "the game provider is unavailable: "+err.Error()))
+		return
+	}
+	ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+}
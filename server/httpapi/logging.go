@@ -0,0 +1,59 @@
+package httpapi
+
+import (
+	"drawydraw/logging"
+	"drawydraw/statemanager"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// logRequests gives every request an ID, sends it back in the X-Request-ID header and logs the request once it's handled.
+// Successful reads are logged at the debug level since clients poll the game status.
+func logRequests(ctx *gin.Context) {
+	start := time.Now()
+	requestID := logging.RequestIDFrom(ctx.GetHeader(requestIDHeader))
+	ctx.Header(requestIDHeader, requestID)
+	ctx.Request = ctx.Request.WithContext(logging.WithRequestID(ctx.Request.Context(), requestID))
+	ctx.Next()
+
+	status := ctx.Writer.Status()
+	level := logging.LevelInfo
+	if status >= http.StatusInternalServerError {
+		level = logging.LevelError
+	} else if ctx.Request.Method == http.MethodGet && status < http.StatusBadRequest {
+		level = logging.LevelDebug
+	}
+	logging.FromContext(ctx.Request.Context()).Log(level, "HTTP request", logging.Fields{
+		"method":     ctx.Request.Method,
+		"path":       ctx.Request.URL.Path,
+		"route":      ctx.FullPath(),
+		"status":     status,
+		"durationMs": float64(time.Since(start).Microseconds()) / 1000,
+		"clientIp":   ctx.ClientIP(),
+		"group":      ctx.GetString(requestGroupNameKey),
+		"player":     ctx.GetString(requestPlayerNameKey),
+	})
+}
+
+// recoverPanics responds with an internal error when a handler panics and logs the panic with its stack
+func recoverPanics(ctx *gin.Context) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		logging.FromContext(ctx.Request.Context()).Error("HTTP handler panicked", logging.Fields{
+			"panic": fmt.Sprint(recovered),
+			"stack": string(debug.Stack()),
+			"path":  ctx.Request.URL.Path,
+		})
+		abortWithErrorResponse(ctx, http.StatusInternalServerError, formatError(ctx, statemanager.ErrorCodeInternal, "The request could not be handled"))
+	}()
+	ctx.Next()
+}
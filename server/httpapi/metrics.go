@@ -0,0 +1,19 @@
+package httpapi
+
+import (
+	"drawydraw/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// observeRequests records every request by the route it matched, static files and unknown paths are counted as other
+func observeRequests(ctx *gin.Context) {
+	start := time.Now()
+	ctx.Next()
+	route := ctx.FullPath()
+	if route == "" {
+		route = "other"
+	}
+	metrics.ObserveRequest(ctx.Request.Method, route, ctx.Writer.Status(), time.Since(start))
+}
@@ -0,0 +1,23 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	// Register the formats drawings can be decoded from
+	_ "image/jpeg"
+	"image/png"
+)
+
+// ConvertToPNG decodes an image and encodes it as a PNG
+func ConvertToPNG(data []byte) ([]byte, error) {
+	decodedImage, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	buffer := bytes.Buffer{}
+	err = png.Encode(&buffer, decodedImage)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,36 @@
+package images
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidDataURL is returned when image data is not a base64 encoded data URL
+var ErrInvalidDataURL = errors.New("image data is not a valid base64 data URL")
+
+// DecodeDataURL splits a base64 data URL (e.g. data:image/png;base64,...) into its media type and decoded bytes
+func DecodeDataURL(dataURL string) (string, []byte, error) {
+	if !strings.HasPrefix(dataURL, "data:") {
+		return "", nil, ErrInvalidDataURL
+	}
+	separatorIndex := strings.Index(dataURL, ",")
+	if separatorIndex < 0 {
+		return "", nil, ErrInvalidDataURL
+	}
+	header := dataURL[len("data:"):separatorIndex]
+	if !strings.HasSuffix(header, ";base64") {
+		return "", nil, ErrInvalidDataURL
+	}
+	mediaType := strings.TrimSuffix(header, ";base64")
+	data, err := base64.StdEncoding.DecodeString(dataURL[separatorIndex+1:])
+	if err != nil {
+		return "", nil, ErrInvalidDataURL
+	}
+	return mediaType, data, nil
+}
+
+// EncodeDataURL creates a base64 data URL out of some data and its media type
+func EncodeDataURL(mediaType string, data []byte) string {
+	return "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(data)
+}
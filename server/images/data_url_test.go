@@ -0,0 +1,29 @@
+package images
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDataURL(t *testing.T) {
+	mediaType, data, err := DecodeDataURL("data:image/png;base64,aGVsbG8=")
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", mediaType)
+	assert.Equal(t, []byte("hello"), data)
+	assert.Equal(t, "data:image/png;base64,aGVsbG8=", EncodeDataURL(mediaType, data))
+}
+
+func TestDecodeDataURL_Invalid(t *testing.T) {
+	invalidDataURLs := []string{
+		"",
+		"someImageData",
+		"data:image/png;base64",
+		"data:image/png,aGVsbG8=",
+		"data:image/png;base64,not base64!",
+	}
+	for _, dataURL := range invalidDataURLs {
+		_, _, err := DecodeDataURL(dataURL)
+		assert.Equal(t, ErrInvalidDataURL, err, dataURL)
+	}
+}
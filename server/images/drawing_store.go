@@ -0,0 +1,56 @@
+package images
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"sync"
+)
+
+var once sync.Once
+
+var validDrawingID = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// ErrDrawingNotFound is returned when loading a drawing that was never saved
+var ErrDrawingNotFound = errors.New("could not find a drawing with that id")
+
+// StoredDrawing is the image of a drawing along with its media type
+type StoredDrawing struct {
+	MediaType string
+	Data      []byte
+}
+
+// DrawingStore defines the interface different providers of drawing image storage implement.
+// Images are stored under the hash of their contents so each image is only kept once.
+type DrawingStore interface {
+	SaveDrawing(drawing *StoredDrawing) (string, error)
+	LoadDrawing(drawingID string) (*StoredDrawing, error)
+	// DeleteDrawing deletes a drawing's image, deleting one that isn't stored does nothing
+	DeleteDrawing(drawingID string) error
+}
+
+var (
+	drawingStore DrawingStore = nil
+)
+
+// GetDrawingStore gets the store to be used for saving and loading drawing images
+func GetDrawingStore() DrawingStore {
+	once.Do(func() {
+		if drawingStore == nil {
+			drawingStore = NewMemoryDrawingStore()
+		}
+	})
+	return drawingStore
+}
+
+// SetDrawingStore changes the store to be used for saving and loading drawing images
+func SetDrawingStore(store DrawingStore) {
+	drawingStore = store
+}
+
+// DrawingID gets the content hash a drawing's image is stored under
+func DrawingID(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
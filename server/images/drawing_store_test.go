@@ -0,0 +1,70 @@
+package images
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A 1x1 PNG so the file store can detect its media type
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00\x1f\x15\xc4\x89\x00\x00\x00\rIDATx\x9cc\xf8\xff\xff?\x00\x05\xfe\x02\xfe\xa7\x35\x81\x84\x00\x00\x00\x00IEND\xaeB`\x82")
+
+func testDrawingStore(t *testing.T, store DrawingStore) {
+	drawingID, err := store.SaveDrawing(&StoredDrawing{MediaType: "image/png", Data: testPNG})
+	assert.Nil(t, err)
+	assert.Equal(t, DrawingID(testPNG), drawingID)
+	// Saving the same image again gives the same id
+	secondDrawingID, err := store.SaveDrawing(&StoredDrawing{MediaType: "image/png", Data: testPNG})
+	assert.Nil(t, err)
+	assert.Equal(t, drawingID, secondDrawingID)
+	drawing, err := store.LoadDrawing(drawingID)
+	assert.Nil(t, err)
+	assert.Equal(t, &StoredDrawing{MediaType: "image/png", Data: testPNG}, drawing)
+	_, err = store.LoadDrawing(DrawingID([]byte("missing")))
+	assert.Equal(t, ErrDrawingNotFound, err)
+	_, err = store.LoadDrawing("../" + drawingID)
+	assert.Equal(t, ErrDrawingNotFound, err)
+	assert.Nil(t, store.DeleteDrawing(drawingID))
+	_, err = store.LoadDrawing(drawingID)
+	assert.Equal(t, ErrDrawingNotFound, err)
+	// Deleting again, or something that was never stored, is fine
+	assert.Nil(t, store.DeleteDrawing(drawingID))
+	assert.Nil(t, store.DeleteDrawing("../"+drawingID))
+}
+
+func TestMemoryDrawingStore(t *testing.T) {
+	testDrawingStore(t, NewMemoryDrawingStore())
+}
+
+func TestMemoryDrawingStore_ForgetsUnusedDrawings(t *testing.T) {
+	store := newMemoryDrawingStore(200 * time.Millisecond)
+	usedDrawingID, err := store.SaveDrawing(&StoredDrawing{MediaType: "image/png", Data: testPNG})
+	assert.Nil(t, err)
+	unusedDrawingID, err := store.SaveDrawing(&StoredDrawing{MediaType: "image/png", Data: []byte("unused")})
+	assert.Nil(t, err)
+	// Loading a drawing keeps it around for longer
+	time.Sleep(120 * time.Millisecond)
+	_, err = store.LoadDrawing(usedDrawingID)
+	assert.Nil(t, err)
+	time.Sleep(120 * time.Millisecond)
+	_, err = store.LoadDrawing(usedDrawingID)
+	assert.Nil(t, err)
+	_, err = store.LoadDrawing(unusedDrawingID)
+	assert.Equal(t, ErrDrawingNotFound, err)
+	// Expired drawings are dropped from memory, not just hidden
+	time.Sleep(300 * time.Millisecond)
+	assert.Zero(t, store.drawings.ItemCount())
+}
+
+func TestFileDrawingStore(t *testing.T) {
+	// t.TempDir needs Go 1.15 and CI runs 1.14
+	directory, err := ioutil.TempDir("", "drawings")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	store, err := NewFileDrawingStore(directory)
+	assert.Nil(t, err)
+	testDrawingStore(t, store)
+}
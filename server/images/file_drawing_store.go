@@ -0,0 +1,74 @@
+package images
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// FileDrawingStore keeps each drawing's image as a file named after its content hash
+type FileDrawingStore struct {
+	directory string
+}
+
+// NewFileDrawingStore creates a drawing store that keeps images under the given directory
+func NewFileDrawingStore(directory string) (*FileDrawingStore, error) {
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileDrawingStore{directory: directory}, nil
+}
+
+// SaveDrawing writes a drawing's image to disk unless it's there already and returns its id
+func (store *FileDrawingStore) SaveDrawing(drawing *StoredDrawing) (string, error) {
+	drawingID := DrawingID(drawing.Data)
+	path := filepath.Join(store.directory, drawingID)
+	// The same contents always get the same id so there's nothing to do if the file exists
+	if _, err := os.Stat(path); err == nil {
+		return drawingID, nil
+	}
+	// Write to a temporary file first so a crash never leaves a partially written image
+	temporaryFile, err := ioutil.TempFile(store.directory, ".drawing-")
+	if err != nil {
+		return "", err
+	}
+	_, err = temporaryFile.Write(drawing.Data)
+	if closeErr := temporaryFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(temporaryFile.Name())
+		return "", err
+	}
+	return drawingID, os.Rename(temporaryFile.Name(), path)
+}
+
+// LoadDrawing reads a drawing's image from disk
+func (store *FileDrawingStore) LoadDrawing(drawingID string) (*StoredDrawing, error) {
+	if !validDrawingID.MatchString(drawingID) {
+		return nil, ErrDrawingNotFound
+	}
+	data, err := ioutil.ReadFile(filepath.Join(store.directory, drawingID))
+	if os.IsNotExist(err) {
+		return nil, ErrDrawingNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Only the image data is kept on disk, the media type can be told from its contents
+	return &StoredDrawing{MediaType: http.DetectContentType(data), Data: data}, nil
+}
+
+// DeleteDrawing removes a drawing's image from disk
+func (store *FileDrawingStore) DeleteDrawing(drawingID string) error {
+	if !validDrawingID.MatchString(drawingID) {
+		return nil
+	}
+	err := os.Remove(filepath.Join(store.directory, drawingID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
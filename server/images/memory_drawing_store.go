@@ -0,0 +1,48 @@
+package images
+
+import (
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+// MemoryDrawingLifetime is how long drawing images are kept in memory after they were last saved or loaded.
+// Games expire after 20 minutes without changes, their drawings go once no one has looked at them for this long.
+const MemoryDrawingLifetime = time.Hour
+
+// MemoryDrawingStore keeps drawing images in memory until they haven't been used for a while
+type MemoryDrawingStore struct {
+	drawings *cache.Cache
+}
+
+// NewMemoryDrawingStore creates an empty in-memory drawing store
+func NewMemoryDrawingStore() *MemoryDrawingStore {
+	return newMemoryDrawingStore(MemoryDrawingLifetime)
+}
+
+func newMemoryDrawingStore(lifetime time.Duration) *MemoryDrawingStore {
+	return &MemoryDrawingStore{drawings: cache.New(lifetime, lifetime/4)}
+}
+
+// SaveDrawing saves a drawing's image in memory and returns its id
+func (store *MemoryDrawingStore) SaveDrawing(drawing *StoredDrawing) (string, error) {
+	drawingID := DrawingID(drawing.Data)
+	store.drawings.Set(drawingID, drawing, cache.DefaultExpiration)
+	return drawingID, nil
+}
+
+// LoadDrawing loads a drawing's image from memory, it's kept for longer since it's still being looked at
+func (store *MemoryDrawingStore) LoadDrawing(drawingID string) (*StoredDrawing, error) {
+	drawing, found := store.drawings.Get(drawingID)
+	if !found {
+		return nil, ErrDrawingNotFound
+	}
+	store.drawings.Set(drawingID, drawing, cache.DefaultExpiration)
+	return drawing.(*StoredDrawing), nil
+}
+
+// DeleteDrawing deletes a drawing's image from memory
+func (store *MemoryDrawingStore) DeleteDrawing(drawingID string) error {
+	store.drawings.Delete(drawingID)
+	return nil
+}
@@ -0,0 +1,180 @@
+package images
+
+import (
+	"bytes"
+	"drawydraw/models"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// StrokeCanvasSize is the width and height of the canvas strokes are drawn on
+	StrokeCanvasSize = 700
+	// MaxStrokeCount is the most strokes a drawing can have
+	MaxStrokeCount = 2000
+	// MaxStrokePointCount is the most points all of a drawing's strokes can have together
+	MaxStrokePointCount = 50000
+	// MaxStrokeWidth is the widest a stroke can be
+	MaxStrokeWidth = 100
+	// MaxStrokeCoverage is the most pixels all of a drawing's strokes can cover together, counting every time
+	// they go over the same spot. It's how long drawing them takes, and is enough to paint over the canvas 20 times.
+	MaxStrokeCoverage = 20 * StrokeCanvasSize * StrokeCanvasSize
+)
+
+var (
+	// ErrNoStrokes is returned when a drawing submitted as strokes doesn't have any
+	ErrNoStrokes = errors.New("drawing does not have any strokes")
+	// ErrTooManyStrokes is returned when a drawing has more strokes or points than allowed, or covers too much to draw
+	ErrTooManyStrokes = errors.New("drawing has too many strokes or points")
+	// ErrInvalidStroke is returned when a stroke has an invalid color, width or points
+	ErrInvalidStroke = errors.New("drawing has an invalid stroke")
+	// ErrInvalidStrokeTiming is returned when stroke points are not in the order they were drawn
+	ErrInvalidStrokeTiming = errors.New("drawing has strokes with out of order timestamps")
+)
+
+var strokeColorFormat = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ValidateStrokes checks that strokes fit in the canvas and limits and were drawn in order
+func ValidateStrokes(strokes []*models.Stroke) error {
+	if len(strokes) == 0 {
+		return ErrNoStrokes
+	}
+	if len(strokes) > MaxStrokeCount {
+		return ErrTooManyStrokes
+	}
+	pointCount := 0
+	coverage := 0.0
+	lastTime := int64(0)
+	for _, stroke := range strokes {
+		if stroke == nil || len(stroke.Points) == 0 ||
+			!strokeColorFormat.MatchString(stroke.Color) ||
+			!(stroke.Width > 0 && stroke.Width <= MaxStrokeWidth) {
+			return ErrInvalidStroke
+		}
+		pointCount += len(stroke.Points)
+		coverage += strokeCoverage(stroke)
+		if pointCount > MaxStrokePointCount || coverage > MaxStrokeCoverage {
+			return ErrTooManyStrokes
+		}
+		for _, point := range stroke.Points {
+			if !(point.X >= 0 && point.X <= StrokeCanvasSize && point.Y >= 0 && point.Y <= StrokeCanvasSize) {
+				return ErrInvalidStroke
+			}
+			if point.Time < lastTime {
+				return ErrInvalidStrokeTiming
+			}
+			lastTime = point.Time
+		}
+	}
+	return nil
+}
+
+// Every point of a stroke covers its width along the segment from the previous point, and a dot as wide at its ends.
+// Drawing checks a pixel more on each side, which is most of the work for thin strokes.
+func strokeCoverage(stroke *models.Stroke) float64 {
+	coverage := 0.0
+	for i, point := range stroke.Points {
+		length := 0.0
+		if i > 0 {
+			length = math.Hypot(point.X-stroke.Points[i-1].X, point.Y-stroke.Points[i-1].Y)
+		}
+		coverage += (length + stroke.Width + 2) * (stroke.Width + 2)
+	}
+	return coverage
+}
+
+// RasterizeStrokes draws strokes on a white canvas and encodes it as a PNG
+func RasterizeStrokes(strokes []*models.Stroke) ([]byte, error) {
+	canvas := newStrokeCanvas()
+	for _, stroke := range strokes {
+		for i := range stroke.Points {
+			drawStrokePoint(canvas, stroke, i)
+		}
+	}
+	buffer := bytes.Buffer{}
+	err := png.Encode(&buffer, canvas)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func newStrokeCanvas() *image.RGBA {
+	canvas := image.NewRGBA(image.Rect(0, 0, StrokeCanvasSize, StrokeCanvasSize))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+	return canvas
+}
+
+// Draws the line from the previous point of a stroke to the point at pointIndex, the first point is a dot.
+// Returns the area of the canvas that could have changed.
+func drawStrokePoint(canvas *image.RGBA, stroke *models.Stroke, pointIndex int) image.Rectangle {
+	start := stroke.Points[pointIndex]
+	if pointIndex > 0 {
+		start = stroke.Points[pointIndex-1]
+	}
+	return drawSegment(canvas, start, stroke.Points[pointIndex], stroke.Width/2, parseStrokeColor(stroke.Color))
+}
+
+// Fills every pixel whose center is within radius of the segment between two points.
+// Long segments are drawn in pieces about as long as they're wide, so only the pixels around each piece are checked
+// instead of every pixel in the box around a long diagonal line. Thin ones still get pieces a few pixels long.
+func drawSegment(canvas *image.RGBA, start models.StrokePoint, end models.StrokePoint, radius float64, segmentColor color.RGBA) image.Rectangle {
+	pieceCount := int(math.Ceil(math.Hypot(end.X-start.X, end.Y-start.Y) / math.Max(2*radius, minSegmentPieceLength)))
+	if pieceCount <= 1 {
+		return drawSegmentPiece(canvas, start, end, radius, segmentColor)
+	}
+	changed := image.Rectangle{}
+	for i := 0; i < pieceCount; i++ {
+		pieceStart := pointAlongSegment(start, end, float64(i)/float64(pieceCount))
+		pieceEnd := pointAlongSegment(start, end, float64(i+1)/float64(pieceCount))
+		changed = changed.Union(drawSegmentPiece(canvas, pieceStart, pieceEnd, radius, segmentColor))
+	}
+	return changed
+}
+
+const minSegmentPieceLength = 8
+
+func pointAlongSegment(start models.StrokePoint, end models.StrokePoint, progress float64) models.StrokePoint {
+	return models.StrokePoint{X: start.X + progress*(end.X-start.X), Y: start.Y + progress*(end.Y-start.Y)}
+}
+
+// Fills the pixels of a piece of a segment, only the part of it on the canvas is drawn
+func drawSegmentPiece(canvas *image.RGBA, start models.StrokePoint, end models.StrokePoint, radius float64, segmentColor color.RGBA) image.Rectangle {
+	bounds := image.Rect(
+		int(math.Floor(math.Min(start.X, end.X)-radius)),
+		int(math.Floor(math.Min(start.Y, end.Y)-radius)),
+		int(math.Ceil(math.Max(start.X, end.X)+radius))+1,
+		int(math.Ceil(math.Max(start.Y, end.Y)+radius))+1,
+	).Intersect(canvas.Bounds())
+	deltaX, deltaY := end.X-start.X, end.Y-start.Y
+	lengthSquared := deltaX*deltaX + deltaY*deltaY
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			pixelX, pixelY := float64(x)+0.5, float64(y)+0.5
+			// Find the closest point of the segment to the pixel
+			progress := 0.0
+			if lengthSquared > 0 {
+				progress = ((pixelX-start.X)*deltaX + (pixelY-start.Y)*deltaY) / lengthSquared
+				progress = math.Max(0, math.Min(1, progress))
+			}
+			distanceX := pixelX - (start.X + progress*deltaX)
+			distanceY := pixelY - (start.Y + progress*deltaY)
+			if distanceX*distanceX+distanceY*distanceY <= radius*radius {
+				canvas.SetRGBA(x, y, segmentColor)
+			}
+		}
+	}
+	return bounds
+}
+
+// Colors are validated before strokes are drawn so they're always #rrggbb
+func parseStrokeColor(hexColor string) color.RGBA {
+	value, _ := strconv.ParseUint(hexColor[1:], 16, 32)
+	return color.RGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 255}
+}
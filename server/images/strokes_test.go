@@ -0,0 +1,126 @@
+package images
+
+import (
+	"bytes"
+	"drawydraw/models"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testStrokes() []*models.Stroke {
+	return []*models.Stroke{
+		{Color: "#ff0000", Width: 10, Points: []models.StrokePoint{{X: 100, Y: 100, Time: 0}, {X: 300, Y: 100, Time: 250}}},
+		{Color: "#0000FF", Width: 4, Points: []models.StrokePoint{{X: 500, Y: 500, Time: 900}}},
+	}
+}
+
+func TestValidateStrokes(t *testing.T) {
+	assert.Nil(t, ValidateStrokes(testStrokes()))
+}
+
+func TestValidateStrokes_Invalid(t *testing.T) {
+	tooManyPoints := make([]models.StrokePoint, MaxStrokePointCount+1)
+	// Wide lines back and forth across the canvas take too long to draw even with few points
+	zigZag := make([]models.StrokePoint, 100)
+	for i := range zigZag {
+		zigZag[i] = models.StrokePoint{X: float64(i%2) * StrokeCanvasSize, Y: float64(i%2) * StrokeCanvasSize, Time: int64(i)}
+	}
+	testCases := []struct {
+		name          string
+		modify        func(strokes []*models.Stroke) []*models.Stroke
+		expectedError error
+	}{
+		{"no strokes", func(strokes []*models.Stroke) []*models.Stroke { return []*models.Stroke{} }, ErrNoStrokes},
+		{"missing stroke", func(strokes []*models.Stroke) []*models.Stroke { return append(strokes, nil) }, ErrInvalidStroke},
+		{"too many strokes", func(strokes []*models.Stroke) []*models.Stroke { return make([]*models.Stroke, MaxStrokeCount+1) }, ErrTooManyStrokes},
+		{"too many points", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Points = tooManyPoints
+			return strokes
+		}, ErrTooManyStrokes},
+		{"too much to draw", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Width = MaxStrokeWidth
+			strokes[0].Points = zigZag
+			return strokes
+		}, ErrTooManyStrokes},
+		{"no points", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Points = nil
+			return strokes
+		}, ErrInvalidStroke},
+		{"named color", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Color = "red"
+			return strokes
+		}, ErrInvalidStroke},
+		{"zero width", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Width = 0
+			return strokes
+		}, ErrInvalidStroke},
+		{"too wide", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Width = MaxStrokeWidth + 1
+			return strokes
+		}, ErrInvalidStroke},
+		{"outside the canvas", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[0].Points[1].X = StrokeCanvasSize + 1
+			return strokes
+		}, ErrInvalidStroke},
+		{"negative coordinate", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[1].Points[0].Y = -1
+			return strokes
+		}, ErrInvalidStroke},
+		{"out of order", func(strokes []*models.Stroke) []*models.Stroke {
+			strokes[1].Points[0].Time = 100
+			return strokes
+		}, ErrInvalidStrokeTiming},
+	}
+	for _, testCase := range testCases {
+		err := ValidateStrokes(testCase.modify(testStrokes()))
+		assert.Equal(t, testCase.expectedError, err, testCase.name)
+	}
+}
+
+func TestRasterizeStrokes(t *testing.T) {
+	pngData, err := RasterizeStrokes(testStrokes())
+	assert.Nil(t, err)
+	rasterizedImage, err := png.Decode(bytes.NewReader(pngData))
+	assert.Nil(t, err)
+	assert.Equal(t, StrokeCanvasSize, rasterizedImage.Bounds().Dx())
+	assert.Equal(t, StrokeCanvasSize, rasterizedImage.Bounds().Dy())
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	// Along and around the line
+	assert.Equal(t, red, color.RGBAModel.Convert(rasterizedImage.At(200, 100)))
+	assert.Equal(t, red, color.RGBAModel.Convert(rasterizedImage.At(200, 103)))
+	// Round caps at the ends of the line
+	assert.Equal(t, red, color.RGBAModel.Convert(rasterizedImage.At(96, 100)))
+	assert.Equal(t, white, color.RGBAModel.Convert(rasterizedImage.At(200, 110)))
+	// A single point is a dot
+	assert.Equal(t, blue, color.RGBAModel.Convert(rasterizedImage.At(500, 500)))
+	assert.Equal(t, white, color.RGBAModel.Convert(rasterizedImage.At(505, 500)))
+	assert.Equal(t, white, color.RGBAModel.Convert(rasterizedImage.At(0, 0)))
+}
+
+func TestRasterizeStrokes_LongDiagonal(t *testing.T) {
+	strokes := []*models.Stroke{
+		{Color: "#000000", Width: 20, Points: []models.StrokePoint{{X: 0, Y: 0, Time: 0}, {X: StrokeCanvasSize, Y: StrokeCanvasSize, Time: 100}}},
+	}
+	assert.Nil(t, ValidateStrokes(strokes))
+	pngData, err := RasterizeStrokes(strokes)
+	assert.Nil(t, err)
+	rasterizedImage, err := png.Decode(bytes.NewReader(pngData))
+	assert.Nil(t, err)
+	black := color.RGBA{A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	// Drawn in pieces, the line is as wide all along and goes to the corners of the canvas
+	for _, position := range []int{0, 123, 350, 577, StrokeCanvasSize - 1} {
+		assert.Equal(t, black, color.RGBAModel.Convert(rasterizedImage.At(position, position)))
+		if position+7 < StrokeCanvasSize {
+			assert.Equal(t, black, color.RGBAModel.Convert(rasterizedImage.At(position+7, position)))
+		}
+		if position+15 < StrokeCanvasSize {
+			assert.Equal(t, white, color.RGBAModel.Convert(rasterizedImage.At(position+15, position)))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// ThumbnailSize is the largest width or height a thumbnail can have
+const ThumbnailSize = 160
+
+// CreateThumbnail decodes an image and encodes a PNG copy that fits in a ThumbnailSize square.
+// Images that are already small enough are returned unchanged.
+func CreateThumbnail(data []byte) ([]byte, error) {
+	sourceImage, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	bounds := sourceImage.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= ThumbnailSize && height <= ThumbnailSize {
+		return data, nil
+	}
+	// Keep the aspect ratio, scaling the longest side down to the thumbnail size
+	thumbnailWidth, thumbnailHeight := ThumbnailSize, ThumbnailSize
+	if width > height {
+		thumbnailHeight = height * ThumbnailSize / width
+	} else {
+		thumbnailWidth = width * ThumbnailSize / height
+	}
+	if thumbnailWidth < 1 {
+		thumbnailWidth = 1
+	}
+	if thumbnailHeight < 1 {
+		thumbnailHeight = 1
+	}
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, thumbnailWidth, thumbnailHeight))
+	for y := 0; y < thumbnailHeight; y++ {
+		for x := 0; x < thumbnailWidth; x++ {
+			// Average every source pixel that falls in this thumbnail pixel
+			sourceArea := image.Rect(
+				bounds.Min.X+x*width/thumbnailWidth,
+				bounds.Min.Y+y*height/thumbnailHeight,
+				bounds.Min.X+(x+1)*width/thumbnailWidth,
+				bounds.Min.Y+(y+1)*height/thumbnailHeight,
+			)
+			thumbnail.Set(x, y, averageColor(sourceImage, sourceArea))
+		}
+	}
+	buffer := bytes.Buffer{}
+	err = png.Encode(&buffer, thumbnail)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func averageColor(sourceImage image.Image, area image.Rectangle) color.Color {
+	var red, green, blue, alpha, count uint64
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			r, g, b, a := sourceImage.At(x, y).RGBA()
+			red, green, blue, alpha = red+uint64(r), green+uint64(g), blue+uint64(b), alpha+uint64(a)
+			count++
+		}
+	}
+	// RGBA returns alpha-premultiplied 16 bit values
+	return color.RGBA64{
+		R: uint16(red / count),
+		G: uint16(green / count),
+		B: uint16(blue / count),
+		A: uint16(alpha / count),
+	}
+}
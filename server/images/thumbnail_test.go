@@ -0,0 +1,47 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateThumbnail_Downscales(t *testing.T) {
+	// Left half red and right half blue
+	sourceImage := image.NewNRGBA(image.Rect(0, 0, 640, 320))
+	for y := 0; y < 320; y++ {
+		for x := 0; x < 640; x++ {
+			if x < 320 {
+				sourceImage.Set(x, y, color.NRGBA{R: 255, A: 255})
+			} else {
+				sourceImage.Set(x, y, color.NRGBA{B: 255, A: 255})
+			}
+		}
+	}
+	buffer := bytes.Buffer{}
+	assert.Nil(t, png.Encode(&buffer, sourceImage))
+	thumbnailData, err := CreateThumbnail(buffer.Bytes())
+	assert.Nil(t, err)
+	thumbnail, err := png.Decode(bytes.NewReader(thumbnailData))
+	assert.Nil(t, err)
+	// The aspect ratio is kept
+	assert.Equal(t, image.Rect(0, 0, ThumbnailSize, ThumbnailSize/2), thumbnail.Bounds())
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(thumbnail.At(10, 10)))
+	assert.Equal(t, color.NRGBA{B: 255, A: 255}, color.NRGBAModel.Convert(thumbnail.At(ThumbnailSize-10, 10)))
+}
+
+func TestCreateThumbnail_SmallImageUnchanged(t *testing.T) {
+	smallImage := encodeTestImage(t, "png", ThumbnailSize, 10)
+	thumbnailData, err := CreateThumbnail(smallImage)
+	assert.Nil(t, err)
+	assert.Equal(t, smallImage, thumbnailData)
+}
+
+func TestCreateThumbnail_InvalidImage(t *testing.T) {
+	_, err := CreateThumbnail([]byte("not an image"))
+	assert.NotNil(t, err)
+}
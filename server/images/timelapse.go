@@ -0,0 +1,143 @@
+package images
+
+import (
+	"bytes"
+	"drawydraw/models"
+	"errors"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"math"
+	"time"
+)
+
+const (
+	// MaxTimelapseFrameRate is the highest frame rate a timelapse can be rendered at
+	MaxTimelapseFrameRate = 30
+	// MaxTimelapseLength is the longest a timelapse can be
+	MaxTimelapseLength = time.Minute
+	// How long the finished drawing stays on screen at the end of a timelapse, in 100ths of a second
+	timelapseFinalFrameDelay = 200
+)
+
+// ErrInvalidTimelapseOptions is returned when a timelapse's frame rate or length is out of bounds
+var ErrInvalidTimelapseOptions = errors.New("timelapse frame rate or length is out of bounds")
+
+// TimelapseOptions controls how a drawing's timelapse is rendered
+type TimelapseOptions struct {
+	FrameRate int
+	// Drawings that took longer than this to make are sped up to fit in it
+	MaxLength time.Duration
+}
+
+// DefaultTimelapseOptions keep timelapses short enough to watch in a gallery
+var DefaultTimelapseOptions = TimelapseOptions{FrameRate: 10, MaxLength: 15 * time.Second}
+
+var timelapseOptions = DefaultTimelapseOptions
+
+// GetTimelapseOptions returns the options timelapses are rendered with when none are requested
+func GetTimelapseOptions() TimelapseOptions {
+	return timelapseOptions
+}
+
+// SetTimelapseOptions changes the options timelapses are rendered with when none are requested
+func SetTimelapseOptions(options TimelapseOptions) {
+	timelapseOptions = options
+}
+
+// RenderTimelapse renders an animated GIF of a drawing's strokes being drawn in the order and pace they were made
+func RenderTimelapse(strokes []*models.Stroke, options TimelapseOptions) ([]byte, error) {
+	if options.FrameRate < 1 || options.FrameRate > MaxTimelapseFrameRate ||
+		options.MaxLength <= 0 || options.MaxLength > MaxTimelapseLength {
+		return nil, ErrInvalidTimelapseOptions
+	}
+	if len(strokes) == 0 {
+		return nil, ErrNoStrokes
+	}
+	lastStroke := strokes[len(strokes)-1]
+	drawingTime := lastStroke.Points[len(lastStroke.Points)-1].Time
+	playbackLength := time.Duration(drawingTime) * time.Millisecond
+	if playbackLength > options.MaxLength {
+		playbackLength = options.MaxLength
+	}
+	frameCount := int(math.Ceil(playbackLength.Seconds() * float64(options.FrameRate)))
+	if frameCount < 1 {
+		frameCount = 1
+	}
+
+	canvas := newStrokeCanvas()
+	framePalette := strokePalette(strokes)
+	animation := &gif.GIF{
+		Config: image.Config{ColorModel: framePalette, Width: StrokeCanvasSize, Height: StrokeCanvasSize},
+	}
+	strokeIndex, pointIndex := 0, 0
+	for frame := 1; frame <= frameCount; frame++ {
+		// Draw every point made up to this frame's share of the drawing time
+		frameTime := drawingTime * int64(frame) / int64(frameCount)
+		changedArea := image.Rectangle{}
+		for strokeIndex < len(strokes) {
+			stroke := strokes[strokeIndex]
+			for pointIndex < len(stroke.Points) && stroke.Points[pointIndex].Time <= frameTime {
+				changedArea = changedArea.Union(drawStrokePoint(canvas, stroke, pointIndex))
+				pointIndex++
+			}
+			if pointIndex < len(stroke.Points) {
+				break
+			}
+			strokeIndex, pointIndex = strokeIndex+1, 0
+		}
+		// The first frame has the whole canvas, later frames only what changed since the previous one
+		if frame == 1 {
+			changedArea = canvas.Bounds()
+		} else if changedArea.Empty() {
+			changedArea = image.Rect(0, 0, 1, 1)
+		}
+		animation.Image = append(animation.Image, palettedArea(canvas, changedArea, framePalette))
+		animation.Delay = append(animation.Delay, 100/options.FrameRate)
+		animation.Disposal = append(animation.Disposal, gif.DisposalNone)
+	}
+	animation.Delay[len(animation.Delay)-1] += timelapseFinalFrameDelay
+
+	buffer := bytes.Buffer{}
+	err := gif.EncodeAll(&buffer, animation)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Strokes are drawn without anti-aliasing so white and the stroke colors are all a frame can have
+func strokePalette(strokes []*models.Stroke) color.Palette {
+	strokePalette := color.Palette{color.RGBA{R: 255, G: 255, B: 255, A: 255}}
+	seenColors := map[color.RGBA]bool{strokePalette[0].(color.RGBA): true}
+	for _, stroke := range strokes {
+		strokeColor := parseStrokeColor(stroke.Color)
+		if !seenColors[strokeColor] {
+			seenColors[strokeColor] = true
+			strokePalette = append(strokePalette, strokeColor)
+		}
+	}
+	// GIFs can't have more than 256 colors so fall back to the closest ones from a standard palette
+	if len(strokePalette) > 256 {
+		return palette.Plan9
+	}
+	return strokePalette
+}
+
+func palettedArea(canvas *image.RGBA, area image.Rectangle, framePalette color.Palette) *image.Paletted {
+	frame := image.NewPaletted(area, framePalette)
+	paletteIndexes := map[color.RGBA]uint8{}
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			pixel := canvas.RGBAAt(x, y)
+			index, found := paletteIndexes[pixel]
+			if !found {
+				index = uint8(framePalette.Index(pixel))
+				paletteIndexes[pixel] = index
+			}
+			frame.SetColorIndex(x, y, index)
+		}
+	}
+	return frame
+}
@@ -0,0 +1,69 @@
+package images
+
+import (
+	"bytes"
+	"drawydraw/models"
+	"image/color"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTimelapse(t *testing.T) {
+	// The test strokes take 900ms to draw
+	timelapse, err := RenderTimelapse(testStrokes(), TimelapseOptions{FrameRate: 10, MaxLength: time.Minute})
+	assert.Nil(t, err)
+	animation, err := gif.DecodeAll(bytes.NewReader(timelapse))
+	assert.Nil(t, err)
+	assert.Len(t, animation.Image, 9)
+	assert.Equal(t, StrokeCanvasSize, animation.Config.Width)
+	assert.Equal(t, 10, animation.Delay[0])
+	// The finished drawing stays on screen for a while
+	assert.Equal(t, 10+timelapseFinalFrameDelay, animation.Delay[8])
+	// The first frame is the whole canvas with the start of the first stroke
+	firstFrame := animation.Image[0]
+	assert.Equal(t, StrokeCanvasSize, firstFrame.Bounds().Dx())
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(firstFrame.At(100, 100)))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(firstFrame.At(200, 100)))
+	// The line is finished by the third frame (250ms in) and the blue dot only shows up in the last frame
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(animation.Image[2].At(200, 100)))
+	assert.True(t, animation.Image[7].Bounds().Dx() < 2)
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, color.RGBAModel.Convert(animation.Image[8].At(500, 500)))
+}
+
+func TestRenderTimelapse_SpedUpToMaxLength(t *testing.T) {
+	strokes := []*models.Stroke{
+		{Color: "#000000", Width: 2, Points: []models.StrokePoint{{X: 1, Y: 1, Time: 0}, {X: 600, Y: 600, Time: 60000}}},
+	}
+	timelapse, err := RenderTimelapse(strokes, TimelapseOptions{FrameRate: 5, MaxLength: 2 * time.Second})
+	assert.Nil(t, err)
+	animation, err := gif.DecodeAll(bytes.NewReader(timelapse))
+	assert.Nil(t, err)
+	assert.Len(t, animation.Image, 10)
+}
+
+func TestRenderTimelapse_InstantDrawing(t *testing.T) {
+	strokes := []*models.Stroke{{Color: "#000000", Width: 2, Points: []models.StrokePoint{{X: 1, Y: 1}}}}
+	timelapse, err := RenderTimelapse(strokes, DefaultTimelapseOptions)
+	assert.Nil(t, err)
+	animation, err := gif.DecodeAll(bytes.NewReader(timelapse))
+	assert.Nil(t, err)
+	assert.Len(t, animation.Image, 1)
+}
+
+func TestRenderTimelapse_InvalidOptions(t *testing.T) {
+	invalidOptions := []TimelapseOptions{
+		{FrameRate: 0, MaxLength: time.Second},
+		{FrameRate: MaxTimelapseFrameRate + 1, MaxLength: time.Second},
+		{FrameRate: 10, MaxLength: 0},
+		{FrameRate: 10, MaxLength: MaxTimelapseLength + time.Second},
+	}
+	for _, options := range invalidOptions {
+		_, err := RenderTimelapse(testStrokes(), options)
+		assert.Equal(t, ErrInvalidTimelapseOptions, err)
+	}
+	_, err := RenderTimelapse(nil, DefaultTimelapseOptions)
+	assert.Equal(t, ErrNoStrokes, err)
+}
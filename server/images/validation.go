@@ -0,0 +1,93 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+)
+
+var (
+	// ErrUnsupportedImageFormat is returned when a drawing is not a PNG or JPEG image
+	ErrUnsupportedImageFormat = errors.New("drawings must be PNG or JPEG images")
+	// ErrImageTooLarge is returned when a drawing is bigger than the configured byte limit
+	ErrImageTooLarge = errors.New("drawing is larger than the maximum allowed size")
+	// ErrImageDimensionsTooLarge is returned when a drawing is wider or taller than the configured limits
+	ErrImageDimensionsTooLarge = errors.New("drawing is wider or taller than the maximum allowed dimensions")
+	// ErrCorruptImage is returned when a drawing claims to be a PNG or JPEG but can't be decoded
+	ErrCorruptImage = errors.New("drawing could not be decoded")
+)
+
+// DrawingLimits bounds the size of the drawings players can submit
+type DrawingLimits struct {
+	MaxBytes  int
+	MaxWidth  int
+	MaxHeight int
+}
+
+// DefaultDrawingLimits leave room for the client's 700x700 canvas on high density screens
+var DefaultDrawingLimits = DrawingLimits{MaxBytes: 5 << 20, MaxWidth: 2048, MaxHeight: 2048}
+
+var drawingLimits = DefaultDrawingLimits
+
+// SetDrawingLimits changes the limits submitted drawings are validated against
+func SetDrawingLimits(limits DrawingLimits) {
+	drawingLimits = limits
+}
+
+// GetDrawingLimits gets the limits submitted drawings are validated against
+func GetDrawingLimits() DrawingLimits {
+	return drawingLimits
+}
+
+// MaxDataURLLength is the longest data URL whose base64 payload can fit the byte limit
+func (limits DrawingLimits) MaxDataURLLength() int {
+	return (limits.MaxBytes/3+1)*4 + len("data:image/jpeg;base64,")
+}
+
+// Media types of data URLs players can submit along with the format name the image package gives them
+var supportedFormats = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpeg",
+}
+
+// NormalizeDrawing validates a drawing's data URL against the configured limits and re-encodes it as a PNG
+func NormalizeDrawing(dataURL string) ([]byte, error) {
+	limits := drawingLimits
+	// Don't bother decoding data URLs whose base64 payload can't possibly fit the limit
+	if len(dataURL) > limits.MaxDataURLLength() {
+		return nil, ErrImageTooLarge
+	}
+	mediaType, data, err := DecodeDataURL(dataURL)
+	if err != nil {
+		return nil, err
+	}
+	expectedFormat, supported := supportedFormats[mediaType]
+	if !supported {
+		return nil, ErrUnsupportedImageFormat
+	}
+	if len(data) > limits.MaxBytes {
+		return nil, ErrImageTooLarge
+	}
+	// Check the header before decoding the whole image so huge images are never decoded
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err == image.ErrFormat || (err == nil && format != expectedFormat) {
+		return nil, ErrUnsupportedImageFormat
+	}
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+	if config.Width > limits.MaxWidth || config.Height > limits.MaxHeight {
+		return nil, ErrImageDimensionsTooLarge
+	}
+	decodedImage, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+	buffer := bytes.Buffer{}
+	err = png.Encode(&buffer, decodedImage)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,87 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeTestImage(t *testing.T, format string, width int, height int) []byte {
+	testImage := image.NewRGBA(image.Rect(0, 0, width, height))
+	testImage.Set(0, 0, color.RGBA{R: 255, A: 255})
+	buffer := bytes.Buffer{}
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buffer, testImage)
+	case "jpeg":
+		err = jpeg.Encode(&buffer, testImage, nil)
+	case "gif":
+		err = gif.Encode(&buffer, testImage, nil)
+	}
+	assert.Nil(t, err)
+	return buffer.Bytes()
+}
+
+func setTestDrawingLimits(t *testing.T, limits DrawingLimits) {
+	previousLimits := drawingLimits
+	SetDrawingLimits(limits)
+	t.Cleanup(func() {
+		SetDrawingLimits(previousLimits)
+	})
+}
+
+func TestNormalizeDrawing_PNG(t *testing.T) {
+	normalizedImage, err := NormalizeDrawing(EncodeDataURL("image/png", encodeTestImage(t, "png", 10, 20)))
+	assert.Nil(t, err)
+	decodedImage, err := png.Decode(bytes.NewReader(normalizedImage))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 10, 20), decodedImage.Bounds())
+}
+
+func TestNormalizeDrawing_JPEG_ConvertsToPNG(t *testing.T) {
+	normalizedImage, err := NormalizeDrawing(EncodeDataURL("image/jpeg", encodeTestImage(t, "jpeg", 10, 20)))
+	assert.Nil(t, err)
+	decodedImage, err := png.Decode(bytes.NewReader(normalizedImage))
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 10, 20), decodedImage.Bounds())
+}
+
+func TestNormalizeDrawing_Rejected(t *testing.T) {
+	setTestDrawingLimits(t, DrawingLimits{MaxBytes: 1 << 20, MaxWidth: 100, MaxHeight: 50})
+	testCases := []struct {
+		name          string
+		dataURL       string
+		expectedError error
+	}{
+		{"not a data url", "someImageData", ErrInvalidDataURL},
+		{"gif", EncodeDataURL("image/gif", encodeTestImage(t, "gif", 10, 10)), ErrUnsupportedImageFormat},
+		{"gif claiming to be png", EncodeDataURL("image/png", encodeTestImage(t, "gif", 10, 10)), ErrUnsupportedImageFormat},
+		{"jpeg claiming to be png", EncodeDataURL("image/png", encodeTestImage(t, "jpeg", 10, 10)), ErrUnsupportedImageFormat},
+		{"garbage", EncodeDataURL("image/png", []byte("not an image at all")), ErrUnsupportedImageFormat},
+		{"truncated png", EncodeDataURL("image/png", encodeTestImage(t, "png", 10, 10)[:40]), ErrCorruptImage},
+		{"too wide", EncodeDataURL("image/png", encodeTestImage(t, "png", 101, 10)), ErrImageDimensionsTooLarge},
+		{"too tall", EncodeDataURL("image/png", encodeTestImage(t, "png", 10, 51)), ErrImageDimensionsTooLarge},
+		{"too many bytes", EncodeDataURL("image/png", make([]byte, 2<<20)), ErrImageTooLarge},
+	}
+	for _, testCase := range testCases {
+		_, err := NormalizeDrawing(testCase.dataURL)
+		assert.Equal(t, testCase.expectedError, err, testCase.name)
+	}
+}
+
+func TestNormalizeDrawing_ByteLimit(t *testing.T) {
+	pngData := encodeTestImage(t, "png", 10, 10)
+	setTestDrawingLimits(t, DrawingLimits{MaxBytes: len(pngData) - 1, MaxWidth: 100, MaxHeight: 100})
+	_, err := NormalizeDrawing(EncodeDataURL("image/png", pngData))
+	assert.Equal(t, ErrImageTooLarge, err)
+	SetDrawingLimits(DrawingLimits{MaxBytes: len(pngData), MaxWidth: 100, MaxHeight: 100})
+	_, err = NormalizeDrawing(EncodeDataURL("image/png", pngData))
+	assert.Nil(t, err)
+}
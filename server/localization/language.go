@@ -0,0 +1,67 @@
+package localization
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language is a language the server has messages in, named by its ISO 639-1 code
+type Language string
+
+const (
+	// English is used whenever a player's language isn't known or supported
+	English Language = "en"
+	// Spanish - Español
+	Spanish Language = "es"
+)
+
+// SupportedLanguages are the languages with messages, the same ones the client has translations for
+var SupportedLanguages = []Language{English, Spanish}
+
+// ParseLanguage finds the supported language for a language tag like "es" or "es-MX"
+func ParseLanguage(tag string) (Language, bool) {
+	primaryTag := strings.ToLower(strings.TrimSpace(strings.SplitN(tag, "-", 2)[0]))
+	for _, language := range SupportedLanguages {
+		if string(language) == primaryTag {
+			return language, true
+		}
+	}
+	return English, false
+}
+
+// FromAcceptLanguage picks the supported language a client prefers the most from an Accept-Language header
+func FromAcceptLanguage(header string) Language {
+	type weightedTag struct {
+		tag    string
+		weight float64
+	}
+	weightedTags := []weightedTag{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		weight := 1.0
+		for _, parameter := range fields[1:] {
+			parameter = strings.TrimSpace(parameter)
+			if strings.HasPrefix(parameter, "q=") {
+				parsedWeight, err := strconv.ParseFloat(strings.TrimPrefix(parameter, "q="), 64)
+				if err != nil {
+					parsedWeight = 0
+				}
+				weight = parsedWeight
+			}
+		}
+		weightedTags = append(weightedTags, weightedTag{tag: fields[0], weight: weight})
+	}
+	// Tags with the same weight keep the order the client listed them in
+	sort.SliceStable(weightedTags, func(i, j int) bool { return weightedTags[i].weight > weightedTags[j].weight })
+	for _, weightedTag := range weightedTags {
+		if weightedTag.weight <= 0 {
+			continue
+		}
+		language, supported := ParseLanguage(weightedTag.tag)
+		if supported {
+			return language
+		}
+	}
+	return English
+}
@@ -0,0 +1,45 @@
+package localization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLanguage(t *testing.T) {
+	language, supported := ParseLanguage("es-MX")
+	assert.True(t, supported)
+	assert.Equal(t, Spanish, language)
+	language, supported = ParseLanguage(" EN ")
+	assert.True(t, supported)
+	assert.Equal(t, English, language)
+	language, supported = ParseLanguage("fr")
+	assert.False(t, supported)
+	assert.Equal(t, English, language)
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	assert.Equal(t, Spanish, FromAcceptLanguage("es-ES,es;q=0.9,en;q=0.8"))
+	assert.Equal(t, Spanish, FromAcceptLanguage("fr-FR, en;q=0.5, es;q=0.7"))
+	assert.Equal(t, English, FromAcceptLanguage("en-US,es"))
+	// Unsupported and rejected languages are skipped
+	assert.Equal(t, Spanish, FromAcceptLanguage("de, en;q=0, es;q=0.1"))
+	assert.Equal(t, English, FromAcceptLanguage("fr, de"))
+	assert.Equal(t, English, FromAcceptLanguage(""))
+}
+
+func TestErrorMessage(t *testing.T) {
+	assert.Equal(t, "No se encontró un grupo con ese nombre", ErrorMessage(Spanish, "GAME_NOT_FOUND"))
+	assert.Equal(t, "Could not find a group with that name", ErrorMessage(English, "GAME_NOT_FOUND"))
+	assert.Equal(t, ErrorMessage(Spanish, "INTERNAL_ERROR"), ErrorMessage(Spanish, "SOMETHING_NEW"))
+	assert.Equal(t, ErrorMessage(English, "GAME_NOT_FOUND"), ErrorMessage(Language("fr"), "GAME_NOT_FOUND"))
+}
+
+func TestErrorMessages_AllLanguagesHaveTheSameCodes(t *testing.T) {
+	for code := range errorMessages[English] {
+		assert.True(t, HasErrorMessage(code), code)
+	}
+	for _, language := range SupportedLanguages {
+		assert.Len(t, errorMessages[language], len(errorMessages[English]))
+	}
+}
@@ -0,0 +1,68 @@
+package localization
+
+// Error messages by language and error code. The codes are the statemanager's error codes,
+// they're plain strings here so this package doesn't need to know about the game.
+var errorMessages = map[Language]map[string]string{
+	English: {
+		"GAME_NOT_FOUND":       "Could not find a group with that name",
+		"GROUP_ALREADY_EXISTS": "There's already a group with that name",
+		"PLAYER_NOT_IN_GAME":   "You're not a player in this group",
+		"NOT_HOST":             "Only the host can do that",
+		"HOST_ALREADY_EXISTS":  "This group already has a host",
+		"WRONG_STATE":          "That can't be done at this point of the game",
+		"GAME_PAUSED":          "The game is paused, wait for the host to resume it",
+		"ALREADY_SUBMITTED":    "You've already submitted this",
+		"NOT_ENOUGH_PLAYERS":   "At least 3 players are needed to play",
+		"VOTED_FOR_OWN_DECOY":  "You can't vote for your own decoy",
+		"INVALID_INPUT":        "Some of the information you entered is missing or invalid",
+		"DRAWING_NOT_FOUND":    "Could not find that drawing",
+		"TRANSCRIPT_NOT_FOUND": "Could not find that game",
+		"NO_TIMELAPSE":         "This drawing can't be replayed",
+		"INVALID_REQUEST":      "The request was invalid",
+		"UNAUTHORIZED":         "You're not allowed to do that",
+		"INTERNAL_ERROR":       "Something went wrong, please try again",
+	},
+	Spanish: {
+		"GAME_NOT_FOUND":       "No se encontró un grupo con ese nombre",
+		"GROUP_ALREADY_EXISTS": "Ya existe un grupo con ese nombre",
+		"PLAYER_NOT_IN_GAME":   "No eres jugador de este grupo",
+		"NOT_HOST":             "Solo el anfitrión puede hacer eso",
+		"HOST_ALREADY_EXISTS":  "Este grupo ya tiene un anfitrión",
+		"WRONG_STATE":          "Eso no se puede hacer en este momento del juego",
+		"GAME_PAUSED":          "El juego está en pausa, espera a que el anfitrión lo reanude",
+		"ALREADY_SUBMITTED":    "Ya enviaste esto",
+		"NOT_ENOUGH_PLAYERS":   "Se necesitan al menos 3 jugadores para jugar",
+		"VOTED_FOR_OWN_DECOY":  "No puedes votar por tu propia descripción falsa",
+		"INVALID_INPUT":        "Falta información o algo de lo que ingresaste no es válido",
+		"DRAWING_NOT_FOUND":    "No se encontró ese dibujo",
+		"TRANSCRIPT_NOT_FOUND": "No se encontró ese juego",
+		"NO_TIMELAPSE":         "Este dibujo no se puede reproducir",
+		"INVALID_REQUEST":      "La solicitud no es válida",
+		"UNAUTHORIZED":         "No tienes permiso para hacer eso",
+		"INTERNAL_ERROR":       "Algo salió mal, por favor intenta de nuevo",
+	},
+}
+
+// ErrorMessage returns the message for an error code in a language.
+// Codes without a message get the one for unexpected errors and unsupported languages get English.
+func ErrorMessage(language Language, code string) string {
+	messages, found := errorMessages[language]
+	if !found {
+		messages = errorMessages[English]
+	}
+	message, found := messages[code]
+	if !found {
+		return messages["INTERNAL_ERROR"]
+	}
+	return message
+}
+
+// HasErrorMessage tells whether an error code has a message in every supported language
+func HasErrorMessage(code string) bool {
+	for _, language := range SupportedLanguages {
+		if _, found := errorMessages[language][code]; !found {
+			return false
+		}
+	}
+	return true
+}
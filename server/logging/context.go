@@ -0,0 +1,48 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+)
+
+type requestIDKey struct{}
+
+// Request IDs sent by clients or proxies are kept when they're short and printable
+var requestIDFormat = regexp.MustCompile(`^[\w.:-]{1,64}$`)
+
+// NewRequestID creates a random identifier for a request
+func NewRequestID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// RequestIDFrom keeps a request ID a client or proxy sent, or creates a new one when it's missing or malformed
+func RequestIDFrom(sentRequestID string) string {
+	if requestIDFormat.MatchString(sentRequestID) {
+		return sentRequestID
+	}
+	return NewRequestID()
+}
+
+// WithRequestID returns a context for a request with an identifier, the messages logged for it include the identifier
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID gets the identifier of the request a context is for, if it has one
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// FromContext gets a logger that adds the identifier of the request a context is for to every message
+func FromContext(ctx context.Context) *Logger {
+	requestID := RequestID(ctx)
+	if requestID == "" {
+		return GetLogger()
+	}
+	return GetLogger().With(Fields{"requestId": requestID})
+}
@@ -0,0 +1,161 @@
+// Package logging writes structured logs as JSON lines
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is how important a log message is, messages below a logger's level are dropped
+type Level int
+
+const (
+	// LevelDebug is for details that are only useful while looking into a problem
+	LevelDebug Level = iota
+	// LevelInfo is for things that happen as part of normal play
+	LevelInfo
+	// LevelWarn is for actions that were rejected
+	LevelWarn
+	// LevelError is for failures that need someone to look at them
+	LevelError
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (level Level) String() string {
+	if level < LevelDebug || level > LevelError {
+		return fmt.Sprintf("level%d", int(level))
+	}
+	return levelNames[level]
+}
+
+// ParseLevel reads a level from its name, like the LOG_LEVEL setting
+func ParseLevel(name string) (Level, error) {
+	for level, levelName := range levelNames {
+		if strings.EqualFold(name, levelName) {
+			return Level(level), nil
+		}
+	}
+	return LevelInfo, fmt.Errorf("unknown log level %q, it should be one of %s", name, strings.Join(levelNames, ", "))
+}
+
+// Fields are the properties logged along with a message
+type Fields map[string]interface{}
+
+// Logger writes messages at or above its level along with its fields, one JSON object per line
+type Logger struct {
+	output *output
+	fields Fields
+}
+
+// output is shared by a logger and the loggers created from it with With
+type output struct {
+	mutex  sync.Mutex
+	writer io.Writer
+	level  Level
+}
+
+// NewLogger creates a logger that writes messages at or above a level
+func NewLogger(writer io.Writer, level Level) *Logger {
+	return &Logger{output: &output{writer: writer, level: level}, fields: Fields{}}
+}
+
+var logger = NewLogger(os.Stderr, LevelInfo)
+
+// GetLogger gets the logger messages without a request are written to
+func GetLogger() *Logger {
+	return logger
+}
+
+// SetLogger changes the logger every message is written to
+func SetLogger(newLogger *Logger) {
+	logger = newLogger
+}
+
+// With creates a logger that adds fields to every message, on top of the ones this logger adds
+func (logger *Logger) With(fields Fields) *Logger {
+	combinedFields := make(Fields, len(logger.fields)+len(fields))
+	for key, value := range logger.fields {
+		combinedFields[key] = value
+	}
+	for key, value := range fields {
+		combinedFields[key] = value
+	}
+	return &Logger{output: logger.output, fields: combinedFields}
+}
+
+// Enabled is whether messages at a level are written, to skip work that's only needed for them
+func (logger *Logger) Enabled(level Level) bool {
+	return level >= logger.output.level
+}
+
+// Debug writes a message at the debug level
+func (logger *Logger) Debug(message string, fields Fields) {
+	logger.Log(LevelDebug, message, fields)
+}
+
+// Info writes a message at the info level
+func (logger *Logger) Info(message string, fields Fields) {
+	logger.Log(LevelInfo, message, fields)
+}
+
+// Warn writes a message at the warn level
+func (logger *Logger) Warn(message string, fields Fields) {
+	logger.Log(LevelWarn, message, fields)
+}
+
+// Error writes a message at the error level
+func (logger *Logger) Error(message string, fields Fields) {
+	logger.Log(LevelError, message, fields)
+}
+
+// Log writes a message with the time, level, the logger's fields and the fields given here, which take precedence.
+// Errors are written as their message and empty strings are left out.
+func (logger *Logger) Log(level Level, message string, fields Fields) {
+	if !logger.Enabled(level) {
+		return
+	}
+	line := bytes.Buffer{}
+	line.WriteString(`{"time":`)
+	writeValue(&line, time.Now().UTC().Format(time.RFC3339Nano))
+	line.WriteString(`,"level":`)
+	writeValue(&line, level.String())
+	line.WriteString(`,"message":`)
+	writeValue(&line, message)
+	combinedFields := logger.With(fields).fields
+	keys := make([]string, 0, len(combinedFields))
+	for key, value := range combinedFields {
+		if value != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		line.WriteByte(',')
+		writeValue(&line, key)
+		line.WriteByte(':')
+		writeValue(&line, combinedFields[key])
+	}
+	line.WriteString("}\n")
+	logger.output.mutex.Lock()
+	defer logger.output.mutex.Unlock()
+	logger.output.writer.Write(line.Bytes())
+}
+
+func writeValue(line *bytes.Buffer, value interface{}) {
+	if err, isError := value.(error); isError {
+		value = err.Error()
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		encodedValue, _ = json.Marshal(fmt.Sprint(value))
+	}
+	line.Write(encodedValue)
+}
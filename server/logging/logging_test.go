@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeLines(t *testing.T, output *bytes.Buffer) []map[string]interface{} {
+	lines := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		decodedLine := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal([]byte(line), &decodedLine), line)
+		lines = append(lines, decodedLine)
+	}
+	return lines
+}
+
+func TestLog_WritesJSONLine(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := NewLogger(output, LevelDebug)
+	logger.Info("Game operation", Fields{"group": "cats", "players": 3, "error": errors.New("no host"), "player": ""})
+	lines := decodeLines(t, output)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "info", lines[0]["level"])
+	assert.Equal(t, "Game operation", lines[0]["message"])
+	assert.Equal(t, "cats", lines[0]["group"])
+	assert.Equal(t, 3.0, lines[0]["players"])
+	assert.Equal(t, "no host", lines[0]["error"])
+	assert.NotContains(t, lines[0], "player")
+	assert.NotEmpty(t, lines[0]["time"])
+	assert.True(t, strings.HasPrefix(output.String(), `{"time":`))
+}
+
+func TestLog_BelowLevel_Dropped(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := NewLogger(output, LevelWarn)
+	logger.Debug("debug", nil)
+	logger.Info("info", nil)
+	logger.Warn("warn", nil)
+	logger.Error("error", nil)
+	lines := decodeLines(t, output)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "warn", lines[0]["level"])
+	assert.Equal(t, "error", lines[1]["level"])
+	assert.False(t, logger.Enabled(LevelInfo))
+	assert.True(t, logger.Enabled(LevelError))
+}
+
+func TestWith_AddsFields(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := NewLogger(output, LevelInfo)
+	groupLogger := logger.With(Fields{"group": "cats", "player": "mama cat"})
+	groupLogger.Info("joined", Fields{"player": "baby cat"})
+	logger.Info("plain", nil)
+	lines := decodeLines(t, output)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "cats", lines[0]["group"])
+	assert.Equal(t, "baby cat", lines[0]["player"])
+	assert.NotContains(t, lines[1], "group")
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("WARN")
+	assert.Nil(t, err)
+	assert.Equal(t, LevelWarn, level)
+	_, err = ParseLevel("loud")
+	assert.NotNil(t, err)
+}
+
+func TestFromContext_AddsRequestID(t *testing.T) {
+	previousLogger := GetLogger()
+	defer SetLogger(previousLogger)
+	output := &bytes.Buffer{}
+	SetLogger(NewLogger(output, LevelInfo))
+	FromContext(WithRequestID(context.Background(), "request-1")).Info("with request", nil)
+	FromContext(context.Background()).Info("without request", nil)
+	lines := decodeLines(t, output)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "request-1", lines[0]["requestId"])
+	assert.NotContains(t, lines[1], "requestId")
+}
+
+func TestRequestIDFrom(t *testing.T) {
+	assert.Equal(t, "abc-123", RequestIDFrom("abc-123"))
+	assert.Len(t, RequestIDFrom(""), 16)
+	assert.NotEqual(t, "bad id\n", RequestIDFrom("bad id\n"))
+	assert.Len(t, RequestIDFrom(strings.Repeat("a", 65)), 16)
+}
@@ -1,224 +1,144 @@
 package main
 
 import (
+	"context"
+	"drawydraw/archive"
+	"drawydraw/grpcapi"
+	"drawydraw/httpapi"
+	"drawydraw/images"
+	"drawydraw/logging"
+	"drawydraw/metrics"
+	"drawydraw/models"
 	"drawydraw/statemanager"
-	"fmt"
+	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/gin-gonic/contrib/static"
-	"github.com/gin-gonic/gin"
 	_ "github.com/heroku/x/hmetrics/onload"
 )
 
-func setupRouter(port string) *gin.Engine {
-	// Use default gin router
-	router := gin.Default()
-
-	// Serve frontend static files
-	router.Use(static.Serve("/", static.LocalFile("./web", true)))
-
-	// API routes
-	router.GET("/api/hello", func(ctx *gin.Context) { ctx.JSON(http.StatusOK, gin.H{"hello": "there"}) })
-	router.GET("/api/get-game-status/:groupName", getGameStatus)
-	// Todo: Rename this to join-game
-	router.POST("/api/add-player", addPlayer)
-	router.POST("/api/create-game", createGroup)
-	router.POST("/api/start-game", startGame)
-	router.POST("/api/add-prompt", addPrompt)
-	router.POST("/api/submit-drawing", submitDrawing)
-	router.POST("/api/cast-vote", castVote)
-
-	// Debug endpoints - delete eventually
-	router.POST("/api/set-game-state", setGameState)
-	return router
-}
-
 func main() {
+	// Logs are written as JSON lines, messages below the configured level are dropped
+	logLevel := logging.LevelInfo
+	if os.Getenv("LOG_LEVEL") != "" {
+		var err error
+		logLevel, err = logging.ParseLevel(os.Getenv("LOG_LEVEL"))
+		if err != nil {
+			log.Fatalf("Failed to set up logging: %s", err.Error())
+		}
+	}
+	logging.SetLogger(logging.NewLogger(os.Stdout, logLevel))
 	port := os.Getenv("PORT")
 	// Todo: Clean this default up for dev environments
 	if port == "" {
 		port = "3000"
 	}
-	router := setupRouter(port)
-	router.Run(":" + port)
-}
-
-// Todo: Probably move each handler / request schema to its own file
-type addPlayerRequest struct {
-	PlayerName string `json:"playerName"`
-	GroupName  string `json:"groupName"`
-}
-
-func addPlayer(ctx *gin.Context) {
-	addPlayerRequest := addPlayerRequest{}
-	err := ctx.BindJSON(&addPlayerRequest)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-	gameState, err := statemanager.AddPlayer(addPlayerRequest.PlayerName, addPlayerRequest.GroupName, false)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error adding player: %s", err.Error())))
-		return
-	}
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-type addPromptRequest struct {
-	PlayerName string `json:"playerName"`
-	GroupName  string `json:"groupName"`
-	Noun       string `json:"noun"`
-	Adjective1 string `json:"adjective1"`
-	Adjective2 string `json:"adjective2"`
-}
-
-func addPrompt(ctx *gin.Context) {
-	addPromptRequest := addPromptRequest{}
-	err := ctx.BindJSON(&addPromptRequest)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-	gameState, err := statemanager.AddPrompt(addPromptRequest.PlayerName, addPromptRequest.GroupName, addPromptRequest.Noun, addPromptRequest.Adjective1, addPromptRequest.Adjective2)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error adding prompt: %s", err.Error())))
-		return
-	}
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-type submitDrawingRequest struct {
-	PlayerName string `json:"playerName"`
-	GroupName  string `json:"groupName"`
-	ImageData  string `json:"imageData"`
-}
-
-func submitDrawing(ctx *gin.Context) {
-	request := submitDrawingRequest{}
-	err := ctx.BindJSON(&request)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-	gameState, err := statemanager.SubmitDrawing(request.PlayerName, request.GroupName, request.ImageData)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error submitting drawing: %s", err.Error())))
-		return
-	}
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-type castVoteRequest struct {
-	PlayerName       string `json:"playerName"`
-	GroupName        string `json:"groupName"`
-	SelectedPromptID string `json:"selectedPromptId"`
-}
-
-func castVote(ctx *gin.Context) {
-	request := castVoteRequest{}
-	err := ctx.BindJSON(&request)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-	gameState, err := statemanager.CastVote(request.PlayerName, request.GroupName, request.SelectedPromptID)
+	// Archived games are kept in memory unless a directory is configured for them
+	archiveDirectory := os.Getenv("ARCHIVE_DIR")
+	if archiveDirectory != "" {
+		fileStore, err := archive.NewFileStore(archiveDirectory)
+		if err != nil {
+			log.Fatalf("Failed to set up the game archive: %s", err.Error())
+		}
+		archive.SetStore(fileStore)
+	}
+	// Drawing images are kept in memory unless a directory is configured for them
+	drawingsDirectory := os.Getenv("DRAWINGS_DIR")
+	if drawingsDirectory != "" {
+		drawingStore, err := images.NewFileDrawingStore(drawingsDirectory)
+		if err != nil {
+			log.Fatalf("Failed to set up the drawing store: %s", err.Error())
+		}
+		images.SetDrawingStore(drawingStore)
+	}
+	images.SetDrawingLimits(images.DrawingLimits{
+		MaxBytes:  intFromEnv("MAX_DRAWING_BYTES", images.DefaultDrawingLimits.MaxBytes),
+		MaxWidth:  intFromEnv("MAX_DRAWING_WIDTH", images.DefaultDrawingLimits.MaxWidth),
+		MaxHeight: intFromEnv("MAX_DRAWING_HEIGHT", images.DefaultDrawingLimits.MaxHeight),
+	})
+	images.SetTimelapseOptions(images.TimelapseOptions{
+		FrameRate: intFromEnv("TIMELAPSE_FRAME_RATE", images.DefaultTimelapseOptions.FrameRate),
+		MaxLength: time.Duration(intFromEnv("TIMELAPSE_MAX_SECONDS", int(images.DefaultTimelapseOptions.MaxLength.Seconds()))) * time.Second,
+	})
+	models.SetGameProvider(metrics.InstrumentGameProvider(models.GetGameProvider()))
+	statemanager.SetBotDelay(time.Duration(intFromEnv("BOT_DELAY_SECONDS", int(statemanager.DefaultBotDelay.Seconds()))) * time.Second)
+	httpapi.SetAdminToken(os.Getenv("ADMIN_TOKEN"))
+	// The gRPC API is served on its own port next to the HTTP one when GRPC_PORT is set.
+	// It's off by default since Heroku only routes PORT, and only over HTTP/1.1.
+	grpcPort := os.Getenv("GRPC_PORT")
+	// Requests in progress get this long to finish once the server is asked to stop, Heroku waits 30 seconds
+	shutdownTimeout := time.Duration(intFromEnv("SHUTDOWN_TIMEOUT_SECONDS", 25)) * time.Second
+	httpServer := &http.Server{Addr: ":" + port, Handler: httpapi.NewRouter()}
+	grpcServer := grpcapi.NewServer()
+	go serveHTTP(httpServer)
+	if grpcPort != "" {
+		go serveGRPC(grpcServer, grpcPort)
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	receivedSignal := <-signals
+	logging.GetLogger().Info("Shutting down", logging.Fields{"signal": receivedSignal.String()})
+	shutdown(httpServer, grpcServer, shutdownTimeout)
+}
+
+func serveHTTP(server *http.Server) {
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		log.Fatalf("Failed to serve HTTP requests: %s", err.Error())
+	}
+}
+
+// The HTTP API keeps being served if the gRPC one fails
+func serveGRPC(server *grpcapi.Server, port string) {
+	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error casting vote: %s", err.Error())))
+		logging.GetLogger().Error("Failed to listen for gRPC requests", logging.Fields{"port": port, "error": err})
 		return
 	}
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-func getGameStatus(ctx *gin.Context) {
-	groupName := ctx.Param("groupName")
-	queryParams := ctx.Request.URL.Query()
-	playerNames, found := queryParams["playerName"]
-	if !found {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: Missing playerName")))
-	}
-	playerName := playerNames[0] // For some strange reason gin returns an array of values
-	gameState, err := statemanager.GetGameState(groupName, playerName)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error getting game status: %s", err.Error())))
-		return
-	}
-	ctx.JSON(http.StatusOK, gameState)
-}
-
-type createGroupRequest struct {
-	PlayerName string `json:"playerName"`
-	GroupName  string `json:"groupName"`
-}
-
-func createGroup(ctx *gin.Context) {
-	createGroupRequest := createGroupRequest{}
-
-	err := ctx.BindJSON(&createGroupRequest)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-
-	// Note: If CreateGroup succeeds but AddPlayer fails the group will be created and the host will be left out :(
-	createGroupError := statemanager.CreateGroup(createGroupRequest.GroupName)
-	if createGroupError != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error creating group: %s", createGroupError.Error())))
-		return
-	}
-
-	gameState, addPlayerError := statemanager.AddPlayer(createGroupRequest.PlayerName, createGroupRequest.GroupName, true)
-	if addPlayerError != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error adding host: %s", addPlayerError.Error())))
-		return
-	}
-
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-type startGameRequest struct {
-	PlayerName string `json:"playerName"`
-	GroupName  string `json:"groupName"`
-}
-
-func startGame(ctx *gin.Context) {
-	request := startGameRequest{}
-	err := ctx.BindJSON(&request) // Todo: Look into request validation
+	err = server.Serve(listener)
 	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("invalid request: %s", err.Error())))
-		return
-	}
-	gameState, startGameError := statemanager.StartGame(request.GroupName, request.PlayerName)
-	if startGameError != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error starting game: %s", startGameError.Error())))
-		return
-	}
-	ctx.JSON(http.StatusOK, &gameState)
-}
-
-func formatError(errorMessage string) map[string]interface{} {
-	return gin.H{"error": errorMessage}
-}
-
-type setStateRequest struct {
-	GameStateName string `json:"gameStateName"`
-}
-
-// Debug method used to test in the UI.
-func setGameState(ctx *gin.Context) {
-	setStateRequest := setStateRequest{}
-
-	err := ctx.BindJSON(&setStateRequest)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Invalid request: %s", err.Error())))
-		return
-	}
-
-	gameState, err := statemanager.SetGameState(setStateRequest.GameStateName)
+		logging.GetLogger().Error("Failed to serve gRPC requests", logging.Fields{"port": port, "error": err})
+	}
+}
+
+// Stops taking new requests and waits for the ones in progress to finish. Games are only kept in memory, so they're
+// lost when the server exits either way.
+func shutdown(httpServer *http.Server, grpcServer *grpcapi.Server, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	httpapi.SetShuttingDown()
+	stopped := sync.WaitGroup{}
+	stopped.Add(2)
+	go func() {
+		defer stopped.Done()
+		err := httpServer.Shutdown(ctx)
+		if err != nil {
+			logging.GetLogger().Error("Failed to finish HTTP requests before shutting down", logging.Fields{"error": err})
+		}
+	}()
+	go func() {
+		defer stopped.Done()
+		grpcServer.Shutdown(ctx)
+	}()
+	stopped.Wait()
+	logging.GetLogger().Info("Shut down", nil)
+}
+
+// Reads an optional numeric setting from the environment
+func intFromEnv(name string, defaultValue int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	parsedValue, err := strconv.Atoi(value)
 	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusBadRequest, formatError(fmt.Sprintf("Error setting GameState: %s", err.Error())))
-		return
+		log.Fatalf("%s must be a number: %s", name, err.Error())
 	}
-
-	ctx.JSON(http.StatusOK, &gameState)
+	return parsedValue
 }
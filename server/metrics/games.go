@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"context"
+	"drawydraw/models"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Every state is reported, even when no game is in it
+var gameStates = []models.GameState{
+	models.WaitingForPlayers,
+	models.InitialPromptCreation,
+	models.DrawingsInProgress,
+	models.DecoyPromptCreation,
+	models.Voting,
+	models.Scoring,
+	models.GameOver,
+}
+
+var playerBuckets = []float64{2, 3, 4, 5, 6, 8, 10, 12}
+
+var (
+	gamesDescription = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "games"),
+		"Games the game provider has by state.",
+		[]string{"state"}, nil,
+	)
+	playersDescription = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "game", "players"),
+		"Number of players in each active game.",
+		nil, nil,
+	)
+)
+
+// gameCollector counts the games in the game provider when metrics are scraped, so the counts can't drift from what's stored
+type gameCollector struct{}
+
+func (collector gameCollector) Describe(descriptions chan<- *prometheus.Desc) {
+	descriptions <- gamesDescription
+	descriptions <- playersDescription
+}
+
+func (collector gameCollector) Collect(metrics chan<- prometheus.Metric) {
+	gamesByState := map[models.GameState]int{}
+	playerCounts := map[float64]uint64{}
+	for _, bucket := range playerBuckets {
+		playerCounts[bucket] = 0
+	}
+	playerSum := 0.0
+	games := models.GetGameProvider().ListGames()
+	for _, game := range games {
+		unlock := models.LockGroup(game.GroupName)
+		state, players := game.CurrentState, float64(len(game.Players))
+		unlock()
+		gamesByState[state]++
+		playerSum += players
+		for _, bucket := range playerBuckets {
+			if players <= bucket {
+				playerCounts[bucket]++
+			}
+		}
+	}
+	for _, state := range gameStates {
+		metrics <- prometheus.MustNewConstMetric(gamesDescription, prometheus.GaugeValue, float64(gamesByState[state]), string(state))
+	}
+	metrics <- prometheus.MustNewConstHistogram(playersDescription, uint64(len(games)), playerSum, playerCounts)
+}
+
+var (
+	providerCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "game_provider",
+		Name:      "calls_total",
+		Help:      "Game provider calls by method and result.",
+	}, []string{"method", "result"})
+	providerCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "game_provider",
+		Name:      "call_duration_seconds",
+		Help:      "How long game provider calls take.",
+		Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 10),
+	}, []string{"method"})
+)
+
+// InstrumentGameProvider wraps a game provider so its calls are counted and timed.
+// Health checks are passed on to the wrapped provider when it supports them.
+func InstrumentGameProvider(provider models.GameProvider) models.GameProvider {
+	return &instrumentedGameProvider{provider: provider}
+}
+
+type instrumentedGameProvider struct {
+	provider models.GameProvider
+}
+
+func (instrumented *instrumentedGameProvider) LoadGame(groupName string) *models.Game {
+	start := time.Now()
+	game := instrumented.provider.LoadGame(groupName)
+	result := "found"
+	if game == nil {
+		result = "missing"
+	}
+	observeProviderCall("LoadGame", result, start)
+	return game
+}
+
+func (instrumented *instrumentedGameProvider) SaveGame(game *models.Game) error {
+	start := time.Now()
+	err := instrumented.provider.SaveGame(game)
+	observeProviderCall("SaveGame", errorResult(err), start)
+	return err
+}
+
+func (instrumented *instrumentedGameProvider) ListGames() []*models.Game {
+	start := time.Now()
+	games := instrumented.provider.ListGames()
+	observeProviderCall("ListGames", "ok", start)
+	return games
+}
+
+func (instrumented *instrumentedGameProvider) DeleteGame(groupName string) error {
+	start := time.Now()
+	err := instrumented.provider.DeleteGame(groupName)
+	observeProviderCall("DeleteGame", errorResult(err), start)
+	return err
+}
+
+func (instrumented *instrumentedGameProvider) CheckHealth(ctx context.Context) error {
+	start := time.Now()
+	err := models.CheckGameProvider(ctx, instrumented.provider)
+	observeProviderCall("CheckHealth", errorResult(err), start)
+	return err
+}
+
+func observeProviderCall(method string, result string, start time.Time) {
+	providerCalls.WithLabelValues(method, result).Inc()
+	providerCallDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func errorResult(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,107 @@
+// Package metrics exposes the server's Prometheus metrics
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "drawydraw"
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, route and status.",
+	}, []string{"method", "route", "status"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "How long HTTP requests take by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	operations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "statemanager",
+		Name:      "operations_total",
+		Help:      "Game operations by operation and error code, OK when they succeed.",
+	}, []string{"operation", "code"})
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "statemanager",
+		Name:      "operation_duration_seconds",
+		Help:      "How long game operations take.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+	transitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "game",
+		Name:      "transitions_total",
+		Help:      "Games moving from one state to another.",
+	}, []string{"from", "to"})
+	phaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "game",
+		Name:      "phase_duration_seconds",
+		Help:      "How long games stay in a state before moving to the next one.",
+		// From a second to a bit over an hour
+		Buckets: prometheus.ExponentialBuckets(1, 2, 13),
+	}, []string{"state"})
+	drawingSize = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "game",
+		Name:      "drawing_size_bytes",
+		Help:      "Size of the images of submitted and draft drawings.",
+		// From a kibibyte to 8 mebibytes
+		Buckets: prometheus.ExponentialBuckets(1024, 2, 14),
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		httpRequests,
+		httpRequestDuration,
+		operations,
+		operationDuration,
+		transitions,
+		phaseDuration,
+		drawingSize,
+		providerCalls,
+		providerCallDuration,
+		gameCollector{},
+	)
+}
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveRequest records an HTTP request to a route
+func ObserveRequest(method string, route string, status int, duration time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ObserveOperation records a game operation along with the code of the error it failed with
+func ObserveOperation(operation string, code string, duration time.Duration) {
+	operations.WithLabelValues(operation, code).Inc()
+	operationDuration.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+// ObserveTransition records a game moving to another state after spending a while in the one before
+func ObserveTransition(from string, to string, timeInPhase time.Duration) {
+	transitions.WithLabelValues(from, to).Inc()
+	phaseDuration.WithLabelValues(from).Observe(timeInPhase.Seconds())
+}
+
+// ObserveDrawingSize records the size of a drawing's image
+func ObserveDrawingSize(bytes int) {
+	drawingSize.Observe(float64(bytes))
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"drawydraw/models"
+	"drawydraw/test"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveTransition_CountsTransitionAndPhaseDuration(t *testing.T) {
+	before := testutil.ToFloat64(transitions.WithLabelValues("Voting", "Scoring"))
+	ObserveTransition("Voting", "Scoring", 90*time.Second)
+	assert.Equal(t, before+1, testutil.ToFloat64(transitions.WithLabelValues("Voting", "Scoring")))
+	assert.Equal(t, 1, testutil.CollectAndCount(phaseDuration))
+}
+
+func TestGameCollector_CountsGamesByStateAndPlayers(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	models.GetGameProvider().SaveGame(test.GameInVotingState())
+	waitingGame := test.GameInWaitingForPlayersState()
+	waitingGame.GroupName = "othergame"
+	models.GetGameProvider().SaveGame(waitingGame)
+	expected := `
+# HELP drawydraw_game_players Number of players in each active game.
+# TYPE drawydraw_game_players histogram
+drawydraw_game_players_bucket{le="2"} 0
+drawydraw_game_players_bucket{le="3"} 2
+drawydraw_game_players_bucket{le="4"} 2
+drawydraw_game_players_bucket{le="5"} 2
+drawydraw_game_players_bucket{le="6"} 2
+drawydraw_game_players_bucket{le="8"} 2
+drawydraw_game_players_bucket{le="10"} 2
+drawydraw_game_players_bucket{le="12"} 2
+drawydraw_game_players_bucket{le="+Inf"} 2
+drawydraw_game_players_sum 6
+drawydraw_game_players_count 2
+# HELP drawydraw_games Games the game provider has by state.
+# TYPE drawydraw_games gauge
+drawydraw_games{state="DecoyPromptCreation"} 0
+drawydraw_games{state="DrawingsInProgress"} 0
+drawydraw_games{state="GameOver"} 0
+drawydraw_games{state="InitialPromptCreation"} 0
+drawydraw_games{state="Scoring"} 0
+drawydraw_games{state="Voting"} 1
+drawydraw_games{state="WaitingForPlayers"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(gameCollector{}, strings.NewReader(expected)))
+}
+
+func TestInstrumentGameProvider_CountsCalls(t *testing.T) {
+	provider := InstrumentGameProvider(test.NewTestGameProvider())
+	missesBefore := testutil.ToFloat64(providerCalls.WithLabelValues("LoadGame", "missing"))
+	savesBefore := testutil.ToFloat64(providerCalls.WithLabelValues("SaveGame", "ok"))
+	game := test.GameInVotingState()
+	assert.Nil(t, provider.LoadGame(game.GroupName))
+	assert.NoError(t, provider.SaveGame(game))
+	assert.Equal(t, game, provider.LoadGame(game.GroupName))
+	assert.Equal(t, missesBefore+1, testutil.ToFloat64(providerCalls.WithLabelValues("LoadGame", "missing")))
+	assert.Equal(t, savesBefore+1, testutil.ToFloat64(providerCalls.WithLabelValues("SaveGame", "ok")))
+}
+
+func TestInstrumentGameProvider_PassesOnHealthChecks(t *testing.T) {
+	testProvider := test.NewTestGameProvider()
+	provider := InstrumentGameProvider(testProvider)
+	ctx := context.Background()
+	assert.NoError(t, models.CheckGameProvider(ctx, provider))
+	testProvider.SetHealthError(errors.New("database is down"))
+	assert.EqualError(t, models.CheckGameProvider(ctx, provider), "database is down")
+}
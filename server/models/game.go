@@ -3,6 +3,8 @@ package models
 import (
 	"fmt"
 	"hash/fnv"
+	"math/rand"
+	"time"
 )
 
 // GameState defines what are the individual states that make up the game
@@ -21,6 +23,8 @@ const (
 	Voting GameState = "Voting"
 	// Scoring - Players are shown the current scores
 	Scoring GameState = "Scoring"
+	// GameOver - All rounds have been played and players are shown the final scores
+	GameOver GameState = "GameOver"
 )
 
 // Player contains all the information relevant to a game's participant
@@ -30,6 +34,10 @@ type Player struct {
 	Host           bool
 	Points         uint64
 	AssignedPrompt *Prompt
+	// Language is the code of the language the player picked for server messages, empty if they haven't picked one
+	Language string
+	// Bot is whether the player is played by the server
+	Bot bool
 }
 
 // Prompt is a set of a noun and adjectives that describes a drawing someone will make or has made
@@ -46,9 +54,27 @@ type Vote struct {
 	SelectedPrompt *Prompt
 }
 
-// Drawing represents a drawing someone has made
+// StrokePoint is a point in a stroke along with how many milliseconds into the drawing it was drawn
+type StrokePoint struct {
+	X    float64
+	Y    float64
+	Time int64
+}
+
+// Stroke is a line drawn without lifting the pen
+type Stroke struct {
+	Color  string
+	Width  float64
+	Points []StrokePoint
+}
+
+// Drawing represents a drawing someone has made, its image and thumbnail are kept in the drawing store.
+// Drawings submitted as strokes also keep them so they can be replayed, along with the timelapse rendered from them.
 type Drawing struct {
-	ImageData      string
+	ImageID        string
+	ThumbnailID    string
+	Strokes        []*Stroke
+	TimelapseID    string
 	Author         string
 	DecoyPrompts   map[string]*Prompt
 	OriginalPrompt *Prompt
@@ -56,14 +82,43 @@ type Drawing struct {
 	Scored         bool
 }
 
-// Game contains all data that represents the game at any point
+// GameSettings contains the options a game was created with
+type GameSettings struct {
+	// AllowSelfVotes lets players vote for the decoy prompt they wrote for a drawing
+	AllowSelfVotes bool
+	// RoundCount is how many rounds are played before the game is over. Games created with 0 get
+	// statemanager.DefaultRoundCount instead, so every game ends.
+	RoundCount uint
+	// DrawingTimeLimit is how long players have to draw before their drafts are submitted for them, 0 means no limit
+	DrawingTimeLimit time.Duration
+}
+
+// Game contains all data that represents the game at any point.
+// DraftDrawings are the drawings players have autosaved but not submitted yet, by author.
+// While the game is paused DrawingDeadline is cleared and DrawingTimeLeft keeps what was left of it.
+// BotTurnsStartedAt is when the bots started waiting to act in the current phase of the game.
+// Phase is the state the game was in when it was last saved and PhaseStartedAt is when it got there, saves use them to tell when the game moves on.
+// Fixture games were made up for trying out the client, they and their rematches are never archived.
 type Game struct {
-	GroupName        string
-	Players          []*Player
-	CurrentState     GameState
-	OriginalPrompts  []*Prompt
-	GeneratedPrompts []*Prompt
-	Drawings         []*Drawing
+	ID                string
+	Fixture           bool
+	StartedAt         time.Time
+	GroupName         string
+	Settings          GameSettings
+	Paused            bool
+	PauseReason       string
+	CompletedRounds   uint
+	Players           []*Player
+	CurrentState      GameState
+	OriginalPrompts   []*Prompt
+	GeneratedPrompts  []*Prompt
+	Drawings          []*Drawing
+	DraftDrawings     map[string]*Drawing
+	DrawingDeadline   *time.Time
+	DrawingTimeLeft   time.Duration
+	BotTurnsStartedAt *time.Time
+	Phase             GameState
+	PhaseStartedAt    time.Time
 }
 
 // GetPromptWithIdentifier returns the prompt that has a given identifier in a drawing
@@ -130,6 +185,11 @@ func (game *Game) GetActiveDrawing() *Drawing {
 	return nil
 }
 
+// NewGameID creates an identifier that tells apart the games played by a group
+func NewGameID() string {
+	return fmt.Sprintf("%d-%d", time.Now().UnixNano(), rand.Intn(1000000))
+}
+
 // BuildPrompt creates a prompt object with the right internal properties
 func BuildPrompt(noun string, adjectives []string, author string) *Prompt {
 	prompt := &Prompt{Noun: noun, Adjectives: adjectives, Author: author}
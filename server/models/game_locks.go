@@ -0,0 +1,42 @@
+package models
+
+import (
+	"sync"
+)
+
+// Games are changed in place, so everything that reads or changes a group's game takes turns through the group's lock
+var groupLocks = struct {
+	sync.Mutex
+	byGroup map[string]*groupLock
+}{byGroup: map[string]*groupLock{}}
+
+type groupLock struct {
+	sync.Mutex
+	// How many are holding or waiting for the lock, it's dropped once no one is
+	users int
+}
+
+// LockGroup waits until no one else is using a group's game, call the returned function once done with it
+func LockGroup(groupName string) (unlock func()) {
+	groupLocks.Lock()
+	lock := groupLocks.byGroup[groupName]
+	if lock == nil {
+		lock = &groupLock{}
+		groupLocks.byGroup[groupName] = lock
+	}
+	lock.users++
+	groupLocks.Unlock()
+	lock.Lock()
+	once := sync.Once{}
+	return func() {
+		once.Do(func() {
+			lock.Unlock()
+			groupLocks.Lock()
+			defer groupLocks.Unlock()
+			lock.users--
+			if lock.users == 0 {
+				delete(groupLocks.byGroup, groupName)
+			}
+		})
+	}
+}
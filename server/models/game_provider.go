@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"sync"
 )
 
@@ -10,6 +11,14 @@ var once sync.Once
 type GameProvider interface {
 	LoadGame(groupName string) *Game
 	SaveGame(game *Game) error
+	// ListGames returns every game the provider has, in no particular order
+	ListGames() []*Game
+	DeleteGame(groupName string) error
+}
+
+// HealthChecker is implemented by game providers that keep games somewhere that can become unreachable, like a database
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
 }
 
 var (
@@ -25,7 +34,15 @@ func GetGameProvider() GameProvider {
 }
 
 // SetGameProvider changes the provider to be used for loading and saving games.
-// This should not be called outside test code
+// The server sets it once at startup to wrap the provider with metrics, tests set a fresh one for each test.
 func SetGameProvider(provider GameProvider) {
 	gameProvider = provider
 }
+
+// CheckGameProvider checks that a provider can load and save games right now, providers that keep games in memory always can
+func CheckGameProvider(ctx context.Context, provider GameProvider) error {
+	if healthChecker, isHealthChecker := provider.(HealthChecker); isHealthChecker {
+		return healthChecker.CheckHealth(ctx)
+	}
+	return nil
+}
@@ -31,3 +31,19 @@ func (provider *MemcacheGameProvider) SaveGame(game *Game) error {
 	provider.internalCache.Set(game.GroupName, game, cache.DefaultExpiration)
 	return nil
 }
+
+// ListGames lists the games in memory that haven't expired
+func (provider *MemcacheGameProvider) ListGames() []*Game {
+	items := provider.internalCache.Items()
+	games := make([]*Game, 0, len(items))
+	for _, item := range items {
+		games = append(games, item.Object.(*Game))
+	}
+	return games
+}
+
+// DeleteGame removes a game from memory
+func (provider *MemcacheGameProvider) DeleteGame(groupName string) error {
+	provider.internalCache.Delete(groupName)
+	return nil
+}
@@ -0,0 +1,214 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Document is an OpenAPI 3 document, only the parts of the specification this server uses are modeled
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       *Info               `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components *Components         `json:"components"`
+	generator  *schemaGenerator
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem has the operations of a path by lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a single route
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+	// Security lists the security schemes the operation requires, by name
+	Security []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter is a value read from the path or the query string of a route
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the JSON body of a route
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is one of the responses a route can give
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType describes the content of a body with a given media type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas and security schemes the rest of the document refers to
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is a way clients authenticate, like HTTP bearer tokens
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// Route describes a route for the document. Its request is a struct whose fields with uri tags naming
+// a parameter of the path are path parameters, form tags are query parameters on GETs, and the rest of its json fields are the body.
+type Route struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Request     interface{}
+	// HeaderParameters is whether the request's fields with header tags are read from headers instead of the body or query
+	HeaderParameters bool
+	// Response is the JSON response, or nil for routes that respond with a file
+	Response interface{}
+	// ResponseEnvelope is the property of an object the JSON response is wrapped in, if it is
+	ResponseEnvelope string
+	// SuccessStatus is the status of successful responses, 200 when it's not set
+	SuccessStatus int
+	// ResponseMediaType is the media type of routes that respond with a file
+	ResponseMediaType string
+	// ErrorResponse is the JSON every failure responds with
+	ErrorResponse interface{}
+	// Security is the name of the security scheme the route requires, if it requires one
+	Security string
+}
+
+// NewDocument creates an empty document for an API
+func NewDocument(info *Info) *Document {
+	generator := newSchemaGenerator()
+	return &Document{
+		OpenAPI:    "3.0.3",
+		Info:       info,
+		Paths:      map[string]PathItem{},
+		Components: &Components{Schemas: generator.schemas},
+		generator:  generator,
+	}
+}
+
+// SetEnum lists the values a string type can have
+func (document *Document) SetEnum(value interface{}, enum []string) {
+	document.generator.enums[reflect.TypeOf(value)] = enum
+}
+
+// AddSecurityScheme adds a security scheme that routes can require by name
+func (document *Document) AddSecurityScheme(name string, scheme *SecurityScheme) {
+	if document.Components.SecuritySchemes == nil {
+		document.Components.SecuritySchemes = map[string]*SecurityScheme{}
+	}
+	document.Components.SecuritySchemes[name] = scheme
+}
+
+// AddRoute adds an operation for a route, with its parameters and schemas generated from its request and response
+func (document *Document) AddRoute(route *Route) {
+	operation := &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Responses:   map[string]*Response{},
+	}
+	if route.Security != "" {
+		operation.Security = []map[string][]string{{route.Security: {}}}
+	}
+	if route.Request != nil {
+		var body *Schema
+		operation.Parameters, body = document.generator.request(reflect.TypeOf(route.Request), route)
+		if body != nil {
+			operation.RequestBody = &RequestBody{
+				Required: body.Ref != "" || len(body.Required) > 0,
+				Content:  map[string]*MediaType{"application/json": {Schema: body}},
+			}
+		}
+	}
+	// Path parameters are always listed, even when they aren't part of a request struct
+	for _, name := range PathParameters(route.Path) {
+		if !hasParameter(operation.Parameters, name) {
+			operation.Parameters = append(operation.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+	success := &Response{Description: "Success"}
+	if route.Response != nil {
+		schema := document.generator.schema(reflect.TypeOf(route.Response))
+		if route.ResponseEnvelope != "" {
+			schema = &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{route.ResponseEnvelope: schema},
+				Required:   []string{route.ResponseEnvelope},
+			}
+		}
+		success.Content = map[string]*MediaType{"application/json": {Schema: schema}}
+	} else if route.ResponseMediaType != "" {
+		success.Content = map[string]*MediaType{route.ResponseMediaType: {Schema: &Schema{Type: "string", Format: "binary"}}}
+	}
+	successStatus := route.SuccessStatus
+	if successStatus == 0 {
+		successStatus = 200
+	}
+	operation.Responses[strconv.Itoa(successStatus)] = success
+	if route.ErrorResponse != nil {
+		operation.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     map[string]*MediaType{"application/json": {Schema: document.generator.schema(reflect.TypeOf(route.ErrorResponse))}},
+		}
+	}
+	path := OpenAPIPath(route.Path)
+	pathItem, found := document.Paths[path]
+	if !found {
+		pathItem = PathItem{}
+		document.Paths[path] = pathItem
+	}
+	pathItem[strings.ToLower(route.Method)] = operation
+}
+
+// OpenAPIPath turns a gin path like /groups/:groupName into an OpenAPI one like /groups/{groupName}
+func OpenAPIPath(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// PathParameters returns the names of the parameters in a gin path
+func PathParameters(ginPath string) []string {
+	names := []string{}
+	for _, segment := range strings.Split(ginPath, "/") {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			names = append(names, segment[1:])
+		}
+	}
+	return names
+}
+
+func hasParameter(parameters []*Parameter, name string) bool {
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package openapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPet struct {
+	Name      string            `json:"name" validate:"required,max=30"`
+	Age       uint              `json:"age" validate:"max=40"`
+	Toys      []string          `json:"toys,omitempty" validate:"max=5"`
+	Friends   map[string]*Child `json:"friends"`
+	BornAt    *time.Time        `json:"bornAt"`
+	Secret    string            `json:"-"`
+	unexposed string
+}
+
+// Child is referred to from testPet
+type Child struct {
+	Name string `json:"name"`
+}
+
+type testPetQuery struct {
+	OwnerName string `uri:"ownerName" validate:"required"`
+	Color     string `form:"color"`
+}
+
+func TestAddRoute_GeneratesSchemas(t *testing.T) {
+	document := NewDocument(&Info{Title: "Pets", Version: "1"})
+	document.AddRoute(&Route{Method: "POST", Path: "/pets", OperationID: "addPet", Request: &testPet{}, Response: &testPet{}})
+	operation := document.Paths["/pets"]["post"]
+	assert.Equal(t, "#/components/schemas/TestPet", operation.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/TestPet", operation.Responses["200"].Content["application/json"].Schema.Ref)
+
+	schema := document.Components.Schemas["TestPet"]
+	assert.Equal(t, []string{"name"}, schema.Required)
+	assert.Len(t, schema.Properties, 5)
+	assert.Equal(t, 30, *schema.Properties["name"].MaxLength)
+	assert.Equal(t, 0.0, *schema.Properties["age"].Minimum)
+	assert.Equal(t, 40.0, *schema.Properties["age"].Maximum)
+	assert.Equal(t, 5, *schema.Properties["toys"].MaxItems)
+	assert.Equal(t, "#/components/schemas/Child", schema.Properties["friends"].AdditionalProperties.Ref)
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time", Nullable: true}, schema.Properties["bornAt"])
+}
+
+func TestAddRoute_ListsParameters(t *testing.T) {
+	document := NewDocument(&Info{Title: "Pets", Version: "1"})
+	document.AddRoute(&Route{Method: "GET", Path: "/owners/:ownerName/pets", Request: &testPetQuery{}, ResponseMediaType: "image/png"})
+	document.AddRoute(&Route{Method: "GET", Path: "/pets/:petId"})
+	operation := document.Paths["/owners/{ownerName}/pets"]["get"]
+	assert.Equal(t, []*Parameter{
+		{Name: "ownerName", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: "color", In: "query", Schema: &Schema{Type: "string"}},
+	}, operation.Parameters)
+	assert.Equal(t, "binary", operation.Responses["200"].Content["image/png"].Schema.Format)
+	// Path parameters are listed even without a request
+	assert.Equal(t, []*Parameter{{Name: "petId", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, document.Paths["/pets/{petId}"]["get"].Parameters)
+}
+
+type testAdoptRequest struct {
+	OwnerName string `json:"ownerName" header:"X-Owner" validate:"required"`
+	PetName   string `json:"petName" uri:"petName" validate:"required"`
+	Note      string `json:"note"`
+}
+
+func TestAddRoute_SplitsResourceRequests(t *testing.T) {
+	document := NewDocument(&Info{Title: "Pets", Version: "1"})
+	document.AddRoute(&Route{
+		Method:           "POST",
+		Path:             "/pets/:petName/adoptions",
+		Request:          &testAdoptRequest{},
+		HeaderParameters: true,
+		Response:         &Child{},
+		ResponseEnvelope: "data",
+		SuccessStatus:    201,
+	})
+	operation := document.Paths["/pets/{petName}/adoptions"]["post"]
+	assert.Equal(t, []*Parameter{
+		{Name: "X-Owner", In: "header", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: "petName", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+	}, operation.Parameters)
+	// Only the fields that aren't parameters are in the body
+	assert.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"note": {Type: "string"}}}, operation.RequestBody.Content["application/json"].Schema)
+	assert.False(t, operation.RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/Child", operation.Responses["201"].Content["application/json"].Schema.Properties["data"].Ref)
+	assert.NotContains(t, operation.Responses, "200")
+
+	// Without header parameters the same request is all body
+	document.AddRoute(&Route{Method: "POST", Path: "/adoptions", Request: &testAdoptRequest{}})
+	operation = document.Paths["/adoptions"]["post"]
+	assert.Empty(t, operation.Parameters)
+	assert.Equal(t, "#/components/schemas/TestAdoptRequest", operation.RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestAddRoute_RequiresSecurityScheme(t *testing.T) {
+	document := NewDocument(&Info{Title: "Pets", Version: "1"})
+	document.AddSecurityScheme("vetToken", &SecurityScheme{Type: "http", Scheme: "bearer"})
+	document.AddRoute(&Route{Method: "DELETE", Path: "/pets/:petId", Security: "vetToken"})
+	document.AddRoute(&Route{Method: "GET", Path: "/pets/:petId"})
+	assert.Equal(t, &SecurityScheme{Type: "http", Scheme: "bearer"}, document.Components.SecuritySchemes["vetToken"])
+	assert.Equal(t, []map[string][]string{{"vetToken": {}}}, document.Paths["/pets/{petId}"]["delete"].Security)
+	assert.Nil(t, document.Paths["/pets/{petId}"]["get"].Security)
+}
+
+func TestSetEnum(t *testing.T) {
+	type color string
+	type testColorQuery struct {
+		Color color `form:"color"`
+	}
+	document := NewDocument(&Info{Title: "Pets", Version: "1"})
+	document.SetEnum(color(""), []string{"black", "orange"})
+	document.AddRoute(&Route{Method: "GET", Path: "/colors", Request: &testColorQuery{}})
+	assert.Equal(t, []string{"black", "orange"}, document.Paths["/colors"]["get"].Parameters[0].Schema.Enum)
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	assert.Equal(t, "/api/games/{groupName}/{gameId}", OpenAPIPath("/api/games/:groupName/:gameId"))
+	assert.Equal(t, []string{"groupName", "gameId"}, PathParameters("/api/games/:groupName/:gameId"))
+	assert.Equal(t, "/api/hello", OpenAPIPath("/api/hello"))
+}
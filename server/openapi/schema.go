@@ -0,0 +1,235 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Schema is a JSON schema as OpenAPI 3 describes them
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Generates schemas from Go types, structs become components named after their type.
+// When types from different packages share a name the later ones are prefixed with their package name.
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+	enums   map[reflect.Type][]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: map[string]*Schema{},
+		names:   map[reflect.Type]string{},
+		enums:   map[reflect.Type][]string{},
+	}
+}
+
+func (generator *schemaGenerator) schema(valueType reflect.Type) *Schema {
+	switch {
+	case valueType == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case valueType.Kind() == reflect.Ptr:
+		schema := generator.schema(valueType.Elem())
+		// References can't be marked as nullable in OpenAPI 3.0
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+		return schema
+	case valueType.Kind() == reflect.Struct:
+		return &Schema{Ref: "#/components/schemas/" + generator.component(valueType)}
+	case valueType.Kind() == reflect.Slice && valueType.Elem().Kind() == reflect.Uint8:
+		return &Schema{Type: "string", Format: "byte"}
+	case valueType.Kind() == reflect.Slice || valueType.Kind() == reflect.Array:
+		return &Schema{Type: "array", Items: generator.schema(valueType.Elem())}
+	case valueType.Kind() == reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: generator.schema(valueType.Elem())}
+	case valueType.Kind() == reflect.String:
+		return &Schema{Type: "string", Enum: generator.enums[valueType]}
+	case valueType.Kind() == reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case valueType.Kind() >= reflect.Int && valueType.Kind() <= reflect.Int64:
+		return &Schema{Type: "integer", Format: integerFormat(valueType)}
+	case valueType.Kind() >= reflect.Uint && valueType.Kind() <= reflect.Uint64:
+		minimum := 0.0
+		return &Schema{Type: "integer", Format: integerFormat(valueType), Minimum: &minimum}
+	case valueType.Kind() == reflect.Float32 || valueType.Kind() == reflect.Float64:
+		return &Schema{Type: "number"}
+	}
+	// Anything else, like an interface, can hold any value
+	return &Schema{}
+}
+
+func integerFormat(valueType reflect.Type) string {
+	if valueType.Bits() == 64 {
+		return "int64"
+	}
+	return "int32"
+}
+
+// Adds the schema of a struct to the components the first time it's used and returns its name
+func (generator *schemaGenerator) component(structType reflect.Type) string {
+	name, found := generator.names[structType]
+	if found {
+		return name
+	}
+	name = exportedName(structType.Name())
+	if _, taken := generator.schemas[name]; taken || name == "" {
+		packagePath := strings.Split(structType.PkgPath(), "/")
+		name = exportedName(packagePath[len(packagePath)-1]) + name
+	}
+	generator.names[structType] = name
+	// The component is registered before its fields so types that refer to themselves don't recurse forever
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	generator.schemas[name] = schema
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		fieldName := jsonName(field)
+		if fieldName == "" {
+			continue
+		}
+		schema.Properties[fieldName] = generator.fieldSchema(field)
+		if isRequired(field) {
+			schema.Required = append(schema.Required, fieldName)
+		}
+	}
+	return name
+}
+
+// Splits a request struct into the parameters of a route and the schema of its body, which is nil when it doesn't have one
+func (generator *schemaGenerator) request(requestType reflect.Type, route *Route) ([]*Parameter, *Schema) {
+	for requestType.Kind() == reflect.Ptr {
+		requestType = requestType.Elem()
+	}
+	pathParameters := map[string]bool{}
+	for _, name := range PathParameters(route.Path) {
+		pathParameters[name] = true
+	}
+	parameters := []*Parameter{}
+	bodyFields := []reflect.StructField{}
+	exposedFieldCount := 0
+	for i := 0; i < requestType.NumField(); i++ {
+		field := requestType.Field(i)
+		if jsonName(field) != "" {
+			exposedFieldCount++
+		}
+		if name := field.Tag.Get("uri"); pathParameters[name] {
+			parameters = append(parameters, &Parameter{Name: name, In: "path", Required: true, Schema: generator.fieldSchema(field)})
+		} else if name := field.Tag.Get("header"); name != "" && route.HeaderParameters {
+			parameters = append(parameters, &Parameter{Name: name, In: "header", Required: isRequired(field), Schema: generator.fieldSchema(field)})
+		} else if name := field.Tag.Get("form"); name != "" && route.Method == "GET" {
+			parameters = append(parameters, &Parameter{Name: name, In: "query", Required: isRequired(field), Schema: generator.fieldSchema(field)})
+		} else if jsonName(field) != "" && route.Method != "GET" {
+			bodyFields = append(bodyFields, field)
+		}
+	}
+	if len(bodyFields) == 0 {
+		return parameters, nil
+	}
+	if len(bodyFields) == exposedFieldCount {
+		return parameters, generator.schema(requestType)
+	}
+	// Bodies that only have some of the request's fields can't share its component
+	body := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, field := range bodyFields {
+		body.Properties[jsonName(field)] = generator.fieldSchema(field)
+		if isRequired(field) {
+			body.Required = append(body.Required, jsonName(field))
+		}
+	}
+	return parameters, body
+}
+
+// The schema of a field's type, along with the limits its validate tag gives it
+func (generator *schemaGenerator) fieldSchema(field reflect.StructField) *Schema {
+	schema := generator.schema(field.Type)
+	if schema.Ref != "" {
+		return schema
+	}
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		parts := strings.SplitN(rule, "=", 2)
+		if len(parts) != 2 || (parts[0] != "min" && parts[0] != "max") {
+			continue
+		}
+		limit, err := strconv.Atoi(parts[1])
+		if err != nil {
+			continue
+		}
+		isMax := parts[0] == "max"
+		switch schema.Type {
+		case "string":
+			setLimit(&schema.MinLength, &schema.MaxLength, isMax, limit)
+		case "array":
+			setLimit(&schema.MinItems, &schema.MaxItems, isMax, limit)
+		case "integer", "number":
+			value := float64(limit)
+			if isMax {
+				schema.Maximum = &value
+			} else {
+				schema.Minimum = &value
+			}
+		}
+	}
+	return schema
+}
+
+func setLimit(minimum **int, maximum **int, isMax bool, limit int) {
+	if isMax {
+		*maximum = &limit
+	} else {
+		*minimum = &limit
+	}
+}
+
+func isRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// The name encoding/json gives a field, or an empty string for fields it leaves out
+func jsonName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return ""
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,266 @@
+package simulation
+
+import (
+	"bytes"
+	"drawydraw/images"
+	"drawydraw/models"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+var promptNouns = []string{"cat", "teapot", "volcano", "robot", "pancake", "lighthouse", "octopus", "bicycle"}
+var promptAdjectives = []string{"grumpy", "sparkly", "tiny", "haunted", "fluffy", "sleepy", "enormous", "wobbly"}
+
+// The parts of the game status a scripted player looks at
+type gameStatus struct {
+	CurrentState  string `json:"currentState"`
+	CurrentPlayer *struct {
+		HasCompletedAction bool `json:"hasCompletedAction"`
+		IsHost             bool `json:"isHost"`
+	} `json:"currentPlayer"`
+	CurrentDrawing *struct {
+		Prompts []*struct {
+			Identifier string `json:"identifier"`
+			Noun       string `json:"noun"`
+		} `json:"prompts"`
+	} `json:"currentDrawing"`
+}
+
+type errorResponse struct {
+	Error  string `json:"error"`
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+// player plays a game through the HTTP API the way the client does, by polling the game status and acting when it's their turn
+type player struct {
+	name      string
+	groupName string
+	index     int
+	host      bool
+	config    *Config
+	stats     *stats
+	random    *rand.Rand
+	// decoyNoun is unique to the player so their decoys never clash with anyone else's
+	decoyNoun string
+}
+
+func newPlayer(config *Config, stats *stats, groupName string, index int) *player {
+	return &player{
+		name:      fmt.Sprintf("player %d", index+1),
+		groupName: groupName,
+		index:     index,
+		host:      index == 0,
+		config:    config,
+		stats:     stats,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano() + int64(index))),
+		decoyNoun: "decoy " + letters(index),
+	}
+}
+
+// join creates the game if the player is the host or adds them to it otherwise
+func (player *player) join() error {
+	if player.host {
+		return player.post("create-game", "/api/create-game", map[string]interface{}{
+			"groupName":  player.groupName,
+			"playerName": player.name,
+			"roundCount": player.config.Rounds,
+		})
+	}
+	return player.post("add-player", "/api/add-player", map[string]interface{}{
+		"groupName":  player.groupName,
+		"playerName": player.name,
+	})
+}
+
+func (player *player) startGame() error {
+	return player.post("start-game", "/api/start-game", map[string]interface{}{
+		"groupName":  player.groupName,
+		"playerName": player.name,
+	})
+}
+
+// play polls the game and takes the player's turns until the game is over or the deadline passes
+func (player *player) play(deadline time.Time) error {
+	for time.Now().Before(deadline) {
+		status, err := player.getGameStatus()
+		if err != nil {
+			// Keep polling, a failed request is already counted as an error
+			time.Sleep(player.config.PollInterval)
+			continue
+		}
+		if status.CurrentState == string(models.GameOver) {
+			return nil
+		}
+		if status.CurrentState == string(models.Scoring) {
+			// The host moves on to the next drawing once everyone has seen the scores
+			if player.host {
+				player.startGame()
+			}
+		} else if status.CurrentPlayer != nil && !status.CurrentPlayer.HasCompletedAction {
+			player.takeTurn(status)
+		}
+		time.Sleep(player.config.PollInterval)
+	}
+	return fmt.Errorf("%s in %s did not finish the game in time", player.name, player.groupName)
+}
+
+// takeTurn does whatever the player has left to do in the current state, failed requests are retried on the next poll
+func (player *player) takeTurn(status *gameStatus) {
+	switch models.GameState(status.CurrentState) {
+	case models.InitialPromptCreation:
+		player.post("add-prompt", "/api/add-prompt", player.promptRequest(promptNouns[player.random.Intn(len(promptNouns))]))
+	case models.DrawingsInProgress:
+		imageData, err := drawingDataURL(player.random)
+		if err != nil {
+			player.stats.recordError("submit-drawing", err)
+			return
+		}
+		player.post("submit-drawing", "/api/submit-drawing", map[string]interface{}{
+			"groupName":  player.groupName,
+			"playerName": player.name,
+			"imageData":  imageData,
+		})
+	case models.DecoyPromptCreation:
+		player.post("add-decoy-prompt", "/api/add-prompt", player.promptRequest(player.decoyNoun))
+	case models.Voting:
+		if status.CurrentDrawing == nil {
+			return
+		}
+		candidates := []string{}
+		for _, prompt := range status.CurrentDrawing.Prompts {
+			if prompt.Noun != player.decoyNoun {
+				candidates = append(candidates, prompt.Identifier)
+			}
+		}
+		if len(candidates) == 0 {
+			return
+		}
+		player.post("cast-vote", "/api/cast-vote", map[string]interface{}{
+			"groupName":        player.groupName,
+			"playerName":       player.name,
+			"selectedPromptId": candidates[player.random.Intn(len(candidates))],
+		})
+	}
+}
+
+func (player *player) promptRequest(noun string) map[string]interface{} {
+	return map[string]interface{}{
+		"groupName":  player.groupName,
+		"playerName": player.name,
+		"noun":       noun,
+		"adjective1": promptAdjectives[player.random.Intn(len(promptAdjectives))],
+		"adjective2": promptAdjectives[player.random.Intn(len(promptAdjectives))],
+	}
+}
+
+func (player *player) getGameStatus() (*gameStatus, error) {
+	query := url.Values{"playerName": {player.name}}
+	request, err := http.NewRequest(http.MethodGet, player.config.URL+"/api/get-game-status/"+url.PathEscape(player.groupName)+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	status := &gameStatus{}
+	err = player.do("get-game-status", request, status)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (player *player) post(operation string, path string, body interface{}) error {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, player.config.URL+path, bytes.NewReader(encodedBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return player.do(operation, request, nil)
+}
+
+// do sends a request, records how long it took and decodes its response into result if there is one
+func (player *player) do(operation string, request *http.Request, result interface{}) error {
+	start := time.Now()
+	response, err := player.config.Client.Do(request)
+	if err != nil {
+		player.stats.recordError(operation, err)
+		return err
+	}
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	player.stats.recordLatency(operation, time.Since(start))
+	if err != nil {
+		player.stats.recordError(operation, err)
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		apiError := errorResponse{}
+		json.Unmarshal(responseBody, &apiError)
+		err = fmt.Errorf("%d %s: %s (%s)", response.StatusCode, apiError.Code, apiError.Error, apiError.Detail)
+		player.stats.recordError(operation, err)
+		return err
+	}
+	if result != nil {
+		err = json.Unmarshal(responseBody, result)
+		if err != nil {
+			player.stats.recordError(operation, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// drawingDataURL scribbles random strokes on a canvas the size of the client's, so the PNG is about as big as a real drawing
+func drawingDataURL(random *rand.Rand) (string, error) {
+	strokes := make([]*models.Stroke, 20+random.Intn(40))
+	for i := range strokes {
+		stroke := &models.Stroke{
+			Color:  fmt.Sprintf("#%06x", random.Intn(0x1000000)),
+			Width:  float64(2 + random.Intn(20)),
+			Points: make([]models.StrokePoint, 10+random.Intn(40)),
+		}
+		x, y := random.Float64()*images.StrokeCanvasSize, random.Float64()*images.StrokeCanvasSize
+		for j := range stroke.Points {
+			x = clamp(x+random.Float64()*60-30, 0, images.StrokeCanvasSize)
+			y = clamp(y+random.Float64()*60-30, 0, images.StrokeCanvasSize)
+			stroke.Points[j] = models.StrokePoint{X: x, Y: y}
+		}
+		strokes[i] = stroke
+	}
+	png, err := images.RasterizeStrokes(strokes)
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
+
+func clamp(value float64, min float64, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// letters spells a number with letters, since prompts can't have digits
+func letters(number int) string {
+	spelled := ""
+	for {
+		spelled = string(rune('a'+number%26)) + spelled
+		number = number/26 - 1
+		if number < 0 {
+			return spelled
+		}
+	}
+}
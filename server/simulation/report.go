@@ -0,0 +1,144 @@
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxErrorSamples is how many error messages a report keeps as examples
+const maxErrorSamples = 10
+
+// OperationReport has the latencies and errors of one kind of request
+type OperationReport struct {
+	Name   string
+	Count  int
+	Errors int
+	P50    time.Duration
+	P90    time.Duration
+	P99    time.Duration
+	Max    time.Duration
+}
+
+// MemoryReport has the server's memory use, read from the Go runtime metrics it serves at /metrics.
+// When the server runs in the simulator's process the figures include the simulated players too.
+type MemoryReport struct {
+	// Measured is whether the server's metrics could be read before and after the simulation
+	Measured bool
+	// HeapAlloc is the heap in use when the simulation finished
+	HeapAlloc uint64
+	// TotalAlloc is how much was allocated while the simulation ran
+	TotalAlloc uint64
+	// Sys is the memory obtained from the OS when the simulation finished
+	Sys uint64
+	// NumGC is how many garbage collections ran while the simulation ran
+	NumGC uint32
+}
+
+// Report is the outcome of a simulation
+type Report struct {
+	Duration        time.Duration
+	Groups          int
+	GroupsCompleted int
+	Operations      []*OperationReport
+	Errors          int
+	ErrorSamples    []string
+	Memory          MemoryReport
+}
+
+// Write prints the report as a table
+func (report *Report) Write(writer io.Writer) {
+	fmt.Fprintf(writer, "Finished %d of %d games in %s\n\n", report.GroupsCompleted, report.Groups, report.Duration.Round(time.Millisecond))
+	fmt.Fprintf(writer, "%-18s %8s %8s %10s %10s %10s %10s\n", "operation", "count", "errors", "p50", "p90", "p99", "max")
+	for _, operation := range report.Operations {
+		fmt.Fprintf(writer, "%-18s %8d %8d %10s %10s %10s %10s\n", operation.Name, operation.Count, operation.Errors,
+			roundLatency(operation.P50), roundLatency(operation.P90), roundLatency(operation.P99), roundLatency(operation.Max))
+	}
+	if report.Memory.Measured {
+		fmt.Fprintf(writer, "\nServer memory: %.1f MiB heap in use, %.1f MiB allocated, %.1f MiB from the OS, %d GCs\n",
+			mebibytes(report.Memory.HeapAlloc), mebibytes(report.Memory.TotalAlloc), mebibytes(report.Memory.Sys), report.Memory.NumGC)
+	} else {
+		fmt.Fprintf(writer, "\nServer memory: unavailable, the server's metrics could not be read\n")
+	}
+	fmt.Fprintf(writer, "Errors: %d\n", report.Errors)
+	for _, sample := range report.ErrorSamples {
+		fmt.Fprintf(writer, "  %s\n", sample)
+	}
+}
+
+func roundLatency(latency time.Duration) time.Duration {
+	return latency.Round(10 * time.Microsecond)
+}
+
+func mebibytes(bytes uint64) float64 {
+	return float64(bytes) / (1 << 20)
+}
+
+// stats collects the latencies and errors of every player's requests
+type stats struct {
+	mutex        sync.Mutex
+	latencies    map[string][]time.Duration
+	errors       map[string]int
+	errorCount   int
+	errorSamples []string
+}
+
+func newStats() *stats {
+	return &stats{latencies: map[string][]time.Duration{}, errors: map[string]int{}}
+}
+
+func (stats *stats) recordLatency(operation string, latency time.Duration) {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	stats.latencies[operation] = append(stats.latencies[operation], latency)
+}
+
+func (stats *stats) recordError(operation string, err error) {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	stats.errors[operation]++
+	stats.errorCount++
+	if len(stats.errorSamples) < maxErrorSamples {
+		stats.errorSamples = append(stats.errorSamples, fmt.Sprintf("%s: %s", operation, err.Error()))
+	}
+}
+
+func (stats *stats) operations() []*OperationReport {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	names := []string{}
+	for name := range stats.latencies {
+		names = append(names, name)
+	}
+	for name := range stats.errors {
+		if _, found := stats.latencies[name]; !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	operations := make([]*OperationReport, 0, len(names))
+	for _, name := range names {
+		latencies := append([]time.Duration{}, stats.latencies[name]...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		operation := &OperationReport{Name: name, Count: len(latencies), Errors: stats.errors[name]}
+		if len(latencies) > 0 {
+			operation.P50 = percentile(latencies, 50)
+			operation.P90 = percentile(latencies, 90)
+			operation.P99 = percentile(latencies, 99)
+			operation.Max = latencies[len(latencies)-1]
+		}
+		operations = append(operations, operation)
+	}
+	return operations
+}
+
+// percentile picks the nearest rank percentile of sorted latencies
+func percentile(sortedLatencies []time.Duration, percent int) time.Duration {
+	rank := (percent*len(sortedLatencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sortedLatencies[rank-1]
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The Go runtime metrics the server exposes at /metrics that a memory report is made of
+const (
+	heapAllocMetric  = "go_memstats_heap_alloc_bytes"
+	totalAllocMetric = "go_memstats_alloc_bytes_total"
+	sysMetric        = "go_memstats_sys_bytes"
+	gcCountMetric    = "go_gc_duration_seconds_count"
+)
+
+// serverMemory is the server's memory use at one point, as read from its metrics
+type serverMemory struct {
+	heapAlloc  uint64
+	totalAlloc uint64
+	sys        uint64
+	numGC      uint32
+}
+
+// readServerMemory reads the server's Go memory metrics from its /metrics route
+func readServerMemory(config *Config) (*serverMemory, error) {
+	response, err := config.Client.Get(config.URL + "/metrics")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics responded with %s", response.Status)
+	}
+	values := map[string]float64{heapAllocMetric: -1, totalAllocMetric: -1, sysMetric: -1, gcCountMetric: -1}
+	// Samples of the text exposition format are a name, a value and an optional timestamp, the wanted metrics have no labels
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if _, wanted := values[fields[0]]; !wanted {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a number: %s", fields[0], err.Error())
+		}
+		values[fields[0]] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for name, value := range values {
+		if value < 0 {
+			return nil, fmt.Errorf("metrics are missing %s", name)
+		}
+	}
+	return &serverMemory{
+		heapAlloc:  uint64(values[heapAllocMetric]),
+		totalAlloc: uint64(values[totalAllocMetric]),
+		sys:        uint64(values[sysMetric]),
+		numGC:      uint32(values[gcCountMetric]),
+	}, nil
+}
@@ -0,0 +1,130 @@
+// Package simulation plays scripted games against the HTTP API to measure how the server holds up under load
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MinPlayers is the fewest players a game can be started with
+const MinPlayers = 3
+
+// Config describes a simulation
+type Config struct {
+	// URL is where the server's API is served, without a trailing slash
+	URL string
+	// Groups is how many games are played at the same time
+	Groups int
+	// Players is how many players are in each game
+	Players int
+	// Rounds is how many rounds each game has
+	Rounds uint
+	// PollInterval is how long players wait between checks of the game status, like the client does
+	PollInterval time.Duration
+	// Timeout is how long games have to finish before their players give up
+	Timeout time.Duration
+	// Client sends the requests, http.DefaultClient when it's not set
+	Client *http.Client
+}
+
+// Run plays the configured games until they all finish or time out and reports how the requests went
+func Run(config Config) (*Report, error) {
+	if config.Groups < 1 {
+		return nil, errors.New("simulations need at least one group")
+	}
+	if config.Players < MinPlayers {
+		return nil, fmt.Errorf("games need at least %d players", MinPlayers)
+	}
+	if config.Rounds < 1 {
+		config.Rounds = 1
+	}
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	stats := newStats()
+	memoryBefore, err := readServerMemory(&config)
+	if err != nil {
+		stats.recordError("read-metrics", err)
+	}
+	start := time.Now()
+	deadline := start.Add(config.Timeout)
+	// Group names are unique to the run so simulations can be repeated against the same server
+	runID := strconv.FormatInt(start.UnixNano()%1e9, 36)
+	completed := make(chan bool, config.Groups)
+	for i := 0; i < config.Groups; i++ {
+		go func(groupName string) {
+			completed <- playGroup(&config, stats, groupName, deadline)
+		}(fmt.Sprintf("sim %s %d", runID, i+1))
+	}
+	report := &Report{Groups: config.Groups}
+	for i := 0; i < config.Groups; i++ {
+		if <-completed {
+			report.GroupsCompleted++
+		}
+	}
+	report.Duration = time.Since(start)
+
+	memoryAfter, err := readServerMemory(&config)
+	if err != nil {
+		stats.recordError("read-metrics", err)
+	}
+	if memoryBefore != nil && memoryAfter != nil {
+		report.Memory = MemoryReport{
+			Measured:   true,
+			HeapAlloc:  memoryAfter.heapAlloc,
+			TotalAlloc: memoryAfter.totalAlloc - memoryBefore.totalAlloc,
+			Sys:        memoryAfter.sys,
+			NumGC:      memoryAfter.numGC - memoryBefore.numGC,
+		}
+	}
+	report.Operations = stats.operations()
+	report.Errors = stats.errorCount
+	report.ErrorSamples = stats.errorSamples
+	return report, nil
+}
+
+// playGroup plays one game from creating it to the end, and returns whether every player saw it finish
+func playGroup(config *Config, stats *stats, groupName string, deadline time.Time) bool {
+	players := make([]*player, config.Players)
+	for i := range players {
+		players[i] = newPlayer(config, stats, groupName, i)
+	}
+	host := players[0]
+	if host.join() != nil {
+		return false
+	}
+	joined := make(chan error, len(players)-1)
+	for _, guest := range players[1:] {
+		go func(guest *player) { joined <- guest.join() }(guest)
+	}
+	for range players[1:] {
+		if <-joined != nil {
+			return false
+		}
+	}
+	if host.startGame() != nil {
+		return false
+	}
+	finished := true
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	for _, p := range players {
+		waitGroup.Add(1)
+		go func(p *player) {
+			defer waitGroup.Done()
+			err := p.play(deadline)
+			if err != nil {
+				stats.recordError("finish-game", err)
+				mutex.Lock()
+				finished = false
+				mutex.Unlock()
+			}
+		}(p)
+	}
+	waitGroup.Wait()
+	return finished
+}
@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"bytes"
+	"drawydraw/httpapi"
+	"drawydraw/logging"
+	"drawydraw/test"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestServer(t *testing.T) string {
+	gin.SetMode(gin.TestMode)
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	test.SetupTestArchiveStore(t)
+	test.SetupTestLogger(t, logging.LevelError)
+	server := httptest.NewServer(httpapi.NewRouter())
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestRun_PlaysEveryGameToTheEnd(t *testing.T) {
+	config := Config{
+		URL:          setupTestServer(t),
+		Groups:       2,
+		Players:      3,
+		Rounds:       1,
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      time.Minute,
+	}
+	report, err := Run(config)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.GroupsCompleted)
+	assert.Equal(t, 0, report.Errors, report.ErrorSamples)
+	operationCounts := map[string]int{}
+	for _, operation := range report.Operations {
+		operationCounts[operation.Name] = operation.Count
+		assert.True(t, operation.P50 <= operation.P90 && operation.P90 <= operation.P99 && operation.P99 <= operation.Max)
+	}
+	assert.Equal(t, 2, operationCounts["create-game"])
+	assert.Equal(t, 4, operationCounts["add-player"])
+	assert.Equal(t, 6, operationCounts["submit-drawing"])
+	// Each of the 3 drawings in a game gets a decoy and a vote from the 2 players who didn't draw it
+	assert.Equal(t, 12, operationCounts["add-decoy-prompt"])
+	assert.Equal(t, 12, operationCounts["cast-vote"])
+	assert.True(t, report.Memory.Measured)
+	assert.True(t, report.Memory.TotalAlloc > 0)
+	assert.True(t, report.Memory.HeapAlloc > 0)
+}
+
+func TestRun_NoMetrics_ReportsMemoryUnavailable(t *testing.T) {
+	// The players still play against a test server, only the metrics route is missing
+	setupTestServer(t)
+	router := httpapi.NewRouter()
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/metrics" {
+			http.NotFound(writer, request)
+			return
+		}
+		router.ServeHTTP(writer, request)
+	}))
+	t.Cleanup(server.Close)
+	report, err := Run(Config{URL: server.URL, Groups: 1, Players: 3, PollInterval: 5 * time.Millisecond, Timeout: time.Minute})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.GroupsCompleted)
+	assert.False(t, report.Memory.Measured)
+	assert.Equal(t, 2, report.Errors)
+	var output bytes.Buffer
+	report.Write(&output)
+	assert.Contains(t, output.String(), "Server memory: unavailable")
+}
+
+func TestRun_RejectsTooFewPlayers(t *testing.T) {
+	_, err := Run(Config{URL: "http://localhost", Groups: 1, Players: 2})
+	assert.Error(t, err)
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []time.Duration{}
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 50*time.Millisecond, percentile(latencies, 50))
+	assert.Equal(t, 99*time.Millisecond, percentile(latencies, 99))
+	assert.Equal(t, 7*time.Millisecond, percentile([]time.Duration{7 * time.Millisecond}, 90))
+}
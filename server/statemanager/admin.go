@@ -0,0 +1,104 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"encoding/json"
+	"sort"
+)
+
+// Operators manage games through these, they skip the checks players go through like who the host is.
+// They get copies of games since the games themselves keep changing once their group's lock is released.
+
+// ListGames returns a copy of every active game sorted by group name
+func ListGames() []*models.Game {
+	games := models.GetGameProvider().ListGames()
+	copies := make([]*models.Game, 0, len(games))
+	for _, game := range games {
+		unlock := models.LockGroup(game.GroupName)
+		copiedGame, err := copyGame(game)
+		unlock()
+		if err != nil {
+			logging.GetLogger().Error("Failed to copy a game", logging.Fields{"group": game.GroupName, "error": err})
+			continue
+		}
+		copies = append(copies, copiedGame)
+	}
+	sort.Slice(copies, func(i, j int) bool {
+		return copies[i].GroupName < copies[j].GroupName
+	})
+	return copies
+}
+
+// Copies everything operators see of a game, which is what it looks like as JSON
+func copyGame(game *models.Game) (*models.Game, error) {
+	data, err := json.Marshal(game)
+	if err != nil {
+		return nil, err
+	}
+	copiedGame := &models.Game{}
+	err = json.Unmarshal(data, copiedGame)
+	if err != nil {
+		return nil, err
+	}
+	return copiedGame, nil
+}
+
+// GetGame gets the full internal state of a group's game
+func GetGame(ctx context.Context, groupName string) (_ *models.Game, err error) {
+	ctx, operation := startOperation(ctx, "GetGame", groupName, "")
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	return copyGame(stateManager.game)
+}
+
+// AdvanceGame forces a game into its next state as if the players who haven't acted yet had skipped their turn.
+// Drawings that are missing get submitted like they are when the drawing time runs out. Prompts can't be made up
+// for players, so a game can't be advanced until everyone has written one. Paused games have to be resumed first.
+func AdvanceGame(ctx context.Context, groupName string) (_ *models.Game, err error) {
+	ctx, operation := startOperation(ctx, "AdvanceGame", groupName, "")
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	err = stateManager.currentState.advance()
+	if err != nil {
+		return nil, err
+	}
+	saveGame(ctx, stateManager.game)
+	return copyGame(stateManager.game)
+}
+
+// ResetGame throws away a game's progress and puts its players back in the waiting room for a new game
+func ResetGame(ctx context.Context, groupName string) (_ *models.Game, err error) {
+	ctx, operation := startOperation(ctx, "ResetGame", groupName, "")
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	game := restartedGame(stateManager.game)
+	saveGame(ctx, game)
+	return copyGame(game)
+}
+
+// DeleteGame removes a group's game, its archived games are kept
+func DeleteGame(ctx context.Context, groupName string) (err error) {
+	ctx, operation := startOperation(ctx, "DeleteGame", groupName, "")
+	defer operation.end(&err)
+	provider := models.GetGameProvider()
+	if provider.LoadGame(groupName) == nil {
+		return ErrGameNotFound
+	}
+	err = provider.DeleteGame(groupName)
+	if err != nil {
+		return err
+	}
+	notifyWatchers(groupName)
+	return nil
+}
@@ -0,0 +1,124 @@
+package statemanager
+
+import (
+	"drawydraw/archive"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"sort"
+	"time"
+)
+
+// archiveRound adds the round that was just scored to the game's transcript.
+// Archiving is best effort: a failure is logged but doesn't get in the way of the game.
+func archiveRound(game *models.Game) {
+	if game.Fixture {
+		return
+	}
+	// Games created before they had ids get one the first time they're archived
+	if game.ID == "" {
+		game.ID = models.NewGameID()
+		game.StartedAt = time.Now()
+	}
+	store := archive.GetStore()
+	transcript, err := store.LoadTranscript(game.GroupName, game.ID)
+	if err == archive.ErrTranscriptNotFound {
+		transcript = &archive.Transcript{
+			GameID:    game.ID,
+			GroupName: game.GroupName,
+			StartedAt: game.StartedAt,
+			Rounds:    []*archive.Round{},
+		}
+	} else if err != nil {
+		logging.GetLogger().Error("Failed to load transcript", logging.Fields{"group": game.GroupName, "error": err})
+		return
+	}
+	transcript.Rounds = append(transcript.Rounds, transcriptRoundFromGame(game))
+	transcript.Players = make([]*archive.PlayerScore, len(game.Players))
+	for i, player := range game.Players {
+		transcript.Players[i] = &archive.PlayerScore{Name: player.Name, Points: player.Points}
+	}
+	if game.CurrentState == models.GameOver {
+		finishedAt := time.Now()
+		transcript.FinishedAt = &finishedAt
+	}
+	err = store.SaveTranscript(transcript)
+	if err != nil {
+		logging.GetLogger().Error("Failed to archive round", logging.Fields{"group": game.GroupName, "error": err})
+	}
+}
+
+func transcriptRoundFromGame(game *models.Game) *archive.Round {
+	round := &archive.Round{
+		Number:   game.CompletedRounds,
+		Drawings: make([]*archive.Drawing, len(game.Drawings)),
+	}
+	for i, drawing := range game.Drawings {
+		round.Drawings[i] = transcriptDrawingFromDrawing(drawing, game)
+	}
+	return round
+}
+
+func transcriptDrawingFromDrawing(drawing *models.Drawing, game *models.Game) *archive.Drawing {
+	transcriptDrawing := &archive.Drawing{
+		Author:         drawing.Author,
+		ImageID:        drawing.ImageID,
+		ImageURL:       drawingImageURL(drawing.ImageID),
+		Strokes:        transcriptStrokesFromStrokes(drawing.Strokes),
+		TimelapseID:    drawing.TimelapseID,
+		OriginalPrompt: transcriptPromptFromPrompt(drawing.OriginalPrompt),
+		DecoyPrompts:   make([]*archive.Prompt, 0, len(drawing.DecoyPrompts)),
+		Votes:          make([]*archive.Vote, 0, len(drawing.Votes)),
+		Points:         []*archive.Points{},
+	}
+	for _, decoyPrompt := range drawing.DecoyPrompts {
+		transcriptDrawing.DecoyPrompts = append(transcriptDrawing.DecoyPrompts, transcriptPromptFromPrompt(decoyPrompt))
+	}
+	sort.Slice(transcriptDrawing.DecoyPrompts, func(i, j int) bool {
+		return transcriptDrawing.DecoyPrompts[i].Author < transcriptDrawing.DecoyPrompts[j].Author
+	})
+	for playerName, vote := range drawing.Votes {
+		transcriptDrawing.Votes = append(
+			transcriptDrawing.Votes,
+			&archive.Vote{Player: playerName, PromptIdentifier: vote.SelectedPrompt.Identifier},
+		)
+	}
+	sort.Slice(transcriptDrawing.Votes, func(i, j int) bool {
+		return transcriptDrawing.Votes[i].Player < transcriptDrawing.Votes[j].Player
+	})
+	// Only the breakdowns are kept since the totals depend on when the drawing was scored
+	standings := scoringState{game: game}.calculateStandings(drawing, game)
+	for _, player := range game.Players {
+		for _, breakdown := range (*standings)[player.Name].RoundPointsBreakdown {
+			transcriptDrawing.Points = append(transcriptDrawing.Points, &archive.Points{
+				Player:        player.Name,
+				Amount:        breakdown.Amount,
+				Reason:        string(breakdown.Reason),
+				CausingPlayer: breakdown.CausingPlayer,
+			})
+		}
+	}
+	return transcriptDrawing
+}
+
+func transcriptPromptFromPrompt(prompt *models.Prompt) *archive.Prompt {
+	return &archive.Prompt{
+		Identifier: prompt.Identifier,
+		Author:     prompt.Author,
+		Noun:       prompt.Noun,
+		Adjectives: prompt.Adjectives,
+	}
+}
+
+func transcriptStrokesFromStrokes(strokes []*models.Stroke) []*archive.Stroke {
+	if strokes == nil {
+		return nil
+	}
+	transcriptStrokes := make([]*archive.Stroke, len(strokes))
+	for i, stroke := range strokes {
+		transcriptStrokes[i] = &archive.Stroke{Color: stroke.Color, Width: stroke.Width}
+		for _, point := range stroke.Points {
+			transcriptStrokes[i].Points = append(transcriptStrokes[i].Points, archive.StrokePoint(point))
+		}
+	}
+	return transcriptStrokes
+}
@@ -0,0 +1,234 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+// Bots fill in for players in small groups. They take their turns through the same state interface players do,
+// one after the other and each BotDelay after the last, starting when the phase they have to act in begins.
+// Like the drawing deadline there are no timers running in the background, bots act the next time anyone loads the game.
+
+// DefaultBotDelay gives players a moment to act before the bots do
+const DefaultBotDelay = 3 * time.Second
+
+// Games for different groups are played at the same time, so the delay is read and changed atomically
+var botDelay = int64(DefaultBotDelay)
+
+// GetBotDelay returns how long each bot waits before taking its turn
+func GetBotDelay() time.Duration {
+	return time.Duration(atomic.LoadInt64(&botDelay))
+}
+
+// SetBotDelay changes how long each bot waits before taking its turn
+func SetBotDelay(delay time.Duration) {
+	atomic.StoreInt64(&botDelay, int64(delay))
+}
+
+// Bots are named after painters, in this order
+var botNames = []string{"Bot Rembrandt", "Bot Frida", "Bot Picasso", "Bot Hokusai", "Bot Monet", "Bot O'Keeffe", "Bot Dali", "Bot Basquiat"}
+
+// Words bots write their prompts and decoys with
+var (
+	botNouns      = []string{"penguin", "castle", "sandwich", "rocket", "giraffe", "lighthouse", "waffle", "wizard", "cactus", "submarine", "pancake", "dinosaur"}
+	botAdjectives = []string{"sparkly", "grumpy", "enormous", "sleepy", "fancy", "soggy", "ancient", "wobbly", "fearless", "tiny", "haunted", "jolly"}
+)
+
+// AddBot lets the host add a bot player while the game is waiting for players
+func AddBot(ctx context.Context, groupName string, hostName string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "AddBot", groupName, hostName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	game := stateManager.game
+	host := game.GetHostName()
+	if host == nil || *host != hostName {
+		return nil, newError(ErrorCodeNotHost, "only the host can add bots")
+	}
+	if game.Paused {
+		return nil, ErrGamePaused
+	}
+	if game.CurrentState != models.WaitingForPlayers {
+		return nil, newError(ErrorCodeWrongState, "bots can only be added while waiting for players")
+	}
+	botName := ""
+	for _, name := range botNames {
+		if !game.IsPlayerInGame(name) {
+			botName = name
+			break
+		}
+	}
+	if botName == "" {
+		return nil, newError(ErrorCodeWrongState, "there's no room for more bots")
+	}
+	err = stateManager.currentState.addPlayer(&models.Player{Name: botName, Bot: true})
+	if err != nil {
+		return nil, err
+	}
+	saveGame(ctx, game)
+	return gameStatusForPlayer(game, hostName)
+}
+
+// scheduleBotTurns starts the bots' wait when they have something to do in the current phase and stops it when they don't
+func scheduleBotTurns(game *models.Game) {
+	if game.Paused || len(pendingBots(game)) == 0 {
+		game.BotTurnsStartedAt = nil
+		return
+	}
+	if game.BotTurnsStartedAt == nil {
+		now := time.Now()
+		game.BotTurnsStartedAt = &now
+	}
+}
+
+// nextBotTurn is when the next bot that has something to do takes its turn
+func nextBotTurn(game *models.Game) *time.Time {
+	if game.Paused || game.BotTurnsStartedAt == nil {
+		return nil
+	}
+	bots := pendingBots(game)
+	if len(bots) == 0 {
+		return nil
+	}
+	turnAt := game.BotTurnsStartedAt.Add(time.Duration(bots[0].turn+1) * GetBotDelay())
+	return &turnAt
+}
+
+// playBotTurns has every bot whose turn has come act, returns whether the game was changed
+func playBotTurns(game *models.Game) bool {
+	changed := false
+	for !game.Paused && game.BotTurnsStartedAt != nil {
+		bots := pendingBots(game)
+		if len(bots) == 0 {
+			return changed
+		}
+		turnAt := game.BotTurnsStartedAt.Add(time.Duration(bots[0].turn+1) * GetBotDelay())
+		if time.Now().Before(turnAt) {
+			return changed
+		}
+		previousState := game.CurrentState
+		previousDrawing := game.GetActiveDrawing()
+		err := takeBotTurn(game, bots[0].player)
+		if err != nil {
+			logging.GetLogger().Error("Bot failed to take its turn", logging.Fields{"group": game.GroupName, "player": bots[0].player.Name, "state": string(game.CurrentState), "error": err})
+			return changed
+		}
+		changed = true
+		// A new phase starts the bots' wait over
+		if game.CurrentState != previousState || game.GetActiveDrawing() != previousDrawing {
+			game.BotTurnsStartedAt = nil
+			scheduleBotTurns(game)
+		}
+	}
+	return changed
+}
+
+type botTurn struct {
+	player *models.Player
+	// turn is the bot's place among the game's bots, the order they act in
+	turn int
+}
+
+// pendingBots lists the bots that have something to do in the current phase, in the order they act
+func pendingBots(game *models.Game) []*botTurn {
+	bots := []*botTurn{}
+	turn := 0
+	for _, player := range game.Players {
+		if !player.Bot {
+			continue
+		}
+		if botHasPendingAction(game, player) {
+			bots = append(bots, &botTurn{player: player, turn: turn})
+		}
+		turn++
+	}
+	return bots
+}
+
+func botHasPendingAction(game *models.Game, bot *models.Player) bool {
+	activeDrawing := game.GetActiveDrawing()
+	switch game.CurrentState {
+	case models.InitialPromptCreation:
+		for _, prompt := range game.OriginalPrompts {
+			if prompt.Author == bot.Name {
+				return false
+			}
+		}
+		return true
+	case models.DrawingsInProgress:
+		for _, drawing := range game.Drawings {
+			if drawing.Author == bot.Name {
+				return false
+			}
+		}
+		return true
+	case models.DecoyPromptCreation:
+		if activeDrawing == nil || activeDrawing.Author == bot.Name {
+			return false
+		}
+		_, hasDecoy := activeDrawing.DecoyPrompts[bot.Name]
+		return !hasDecoy
+	case models.Voting:
+		if activeDrawing == nil || activeDrawing.Author == bot.Name {
+			return false
+		}
+		_, hasVoted := activeDrawing.Votes[bot.Name]
+		return !hasVoted
+	}
+	return false
+}
+
+func takeBotTurn(game *models.Game, bot *models.Player) error {
+	currentState, err := getActiveState(game)
+	if err != nil {
+		return err
+	}
+	switch game.CurrentState {
+	case models.InitialPromptCreation:
+		return currentState.addPrompt(randomBotPrompt(bot.Name, nil))
+	case models.DrawingsInProgress:
+		// Bots can't draw, they submit a blank canvas like players who run out of time without a draft
+		drawing, err := blankDrawing(bot.Name)
+		if err != nil {
+			return err
+		}
+		return currentState.submitDrawing(drawing, nil)
+	case models.DecoyPromptCreation:
+		return currentState.addPrompt(randomBotPrompt(bot.Name, game.GetActiveDrawing()))
+	case models.Voting:
+		return currentState.castVote(bot, randomBotVote(game, bot))
+	}
+	return newError(ErrorCodeWrongState, "bots have nothing to do in this state")
+}
+
+// Bots make up prompts out of random words, decoys are made up again until they're different from the drawing's other prompts
+func randomBotPrompt(botName string, drawing *models.Drawing) *models.Prompt {
+	for {
+		prompt := models.BuildPrompt(
+			botNouns[rand.Intn(len(botNouns))],
+			[]string{botAdjectives[rand.Intn(len(botAdjectives))], botAdjectives[rand.Intn(len(botAdjectives))]},
+			botName,
+		)
+		if drawing == nil || drawing.GetPromptWithIdentifier(prompt.Identifier) == nil {
+			return prompt
+		}
+	}
+}
+
+// Bots vote for any prompt of the drawing but their own decoy
+func randomBotVote(game *models.Game, bot *models.Player) string {
+	drawing := game.GetActiveDrawing()
+	choices := []string{drawing.OriginalPrompt.Identifier}
+	for author, decoy := range drawing.DecoyPrompts {
+		if author != bot.Name {
+			choices = append(choices, decoy.Identifier)
+		}
+	}
+	return choices[rand.Intn(len(choices))]
+}
@@ -2,7 +2,6 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 )
 
 type decoyPromptCreatingState struct {
@@ -14,41 +13,54 @@ func (state decoyPromptCreatingState) addPlayer(player *models.Player) error {
 	if state.game.IsPlayerInGame(player.Name) {
 		return nil
 	}
-	return errors.New("Cannot add new players to a game in this state")
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game in this state")
 }
 
 func (state decoyPromptCreatingState) startGame(groupName string, playerName string) error {
-	return errors.New("startGame not supported for decoyPromptCreatingStage state")
+	return newError(ErrorCodeWrongState, "startGame not supported for decoyPromptCreatingStage state")
 }
 
-func (state decoyPromptCreatingState) submitDrawing(playerName string, encodedImage string) error {
-	return errors.New("submitDrawing not supported for decoyPromptCreatingStage state")
+func (state decoyPromptCreatingState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "submitDrawing not supported for decoyPromptCreatingStage state")
 
 }
 
+func (state decoyPromptCreatingState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
+}
+
 func (state decoyPromptCreatingState) addPrompt(prompt *models.Prompt) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("Cannot submit a prompt when there's no current drawing")
+		return newError(ErrorCodeWrongState, "Cannot submit a prompt when there's no current drawing")
+	}
+	if activeDrawing.Author == prompt.Author {
+		return newError(ErrorCodeWrongState, "Players can't submit decoy prompts for their own drawing")
 	}
 	if _, hasPrompt := activeDrawing.DecoyPrompts[prompt.Author]; hasPrompt {
-		return errors.New("Player has already submitted a prompt for this drawing")
+		return newError(ErrorCodeAlreadySubmitted, "Player has already submitted a prompt for this drawing")
 	}
 	activeDrawing.DecoyPrompts[prompt.Author] = prompt
 	// If all players have added their prompts move to the voting state
 	if len(activeDrawing.DecoyPrompts) == len(state.game.Players)-1 {
-		state.game.CurrentState = models.Voting
+		return state.startVoting()
 	}
 	return nil
 }
 
+// Players vote on the decoys that were written, even when some players didn't write one
+func (state decoyPromptCreatingState) startVoting() error {
+	state.game.CurrentState = models.Voting
+	return nil
+}
+
 func (state decoyPromptCreatingState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("There is no active drawing available for this state")
+		return newError(ErrorCodeWrongState, "There is no active drawing available for this state")
 	}
 	gameStatus.CurrentDrawing = &Drawing{
-		ImageData: activeDrawing.ImageData,
+		ImageURL: drawingImageURL(activeDrawing.ImageID),
 	}
 	authorToDecoyPromptMap := map[string]*models.Prompt{}
 	for _, currentPrompt := range activeDrawing.DecoyPrompts {
@@ -73,5 +85,9 @@ func (state decoyPromptCreatingState) addGameStatusPropertiesForPlayer(player *m
 }
 
 func (state decoyPromptCreatingState) castVote(player *models.Player, promptIdentifier string) error {
-	return errors.New("Casting votes is not allowed at this stage of the game")
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed at this stage of the game")
+}
+
+func (state decoyPromptCreatingState) advance() error {
+	return state.startVoting()
 }
@@ -0,0 +1,114 @@
+package statemanager
+
+import (
+	"drawydraw/images"
+	"drawydraw/logging"
+	"drawydraw/models"
+	"time"
+)
+
+// startDrawingTimer sets the deadline for the drawings of a round if the game has a drawing time limit
+func startDrawingTimer(game *models.Game) {
+	game.DraftDrawings = map[string]*models.Drawing{}
+	if game.Settings.DrawingTimeLimit <= 0 {
+		return
+	}
+	deadline := time.Now().Add(game.Settings.DrawingTimeLimit)
+	game.DrawingDeadline = &deadline
+}
+
+func stopDrawingTimer(game *models.Game) {
+	game.DraftDrawings = nil
+	game.DrawingDeadline = nil
+	game.DrawingTimeLeft = 0
+}
+
+// pauseDrawingTimer keeps whatever was left of the drawing time so it can be given back on resume
+func pauseDrawingTimer(game *models.Game) {
+	if game.DrawingDeadline == nil {
+		return
+	}
+	game.DrawingTimeLeft = time.Until(*game.DrawingDeadline)
+	game.DrawingDeadline = nil
+}
+
+func resumeDrawingTimer(game *models.Game) {
+	if game.DrawingTimeLeft <= 0 {
+		return
+	}
+	deadline := time.Now().Add(game.DrawingTimeLeft)
+	game.DrawingDeadline = &deadline
+	game.DrawingTimeLeft = 0
+}
+
+// enforceDrawingDeadline submits the drawings of everyone who hasn't submitted one once the deadline has passed.
+// Players get their latest draft submitted, or a blank canvas if they never saved one, so the round can go on.
+// There are no timers running in the background, so this happens the next time anyone loads the game.
+// Returns whether the game was changed.
+func enforceDrawingDeadline(game *models.Game) bool {
+	if game.Paused || game.CurrentState != models.DrawingsInProgress || game.DrawingDeadline == nil {
+		return false
+	}
+	if time.Now().Before(*game.DrawingDeadline) {
+		return false
+	}
+	err := submitMissingDrawings(game)
+	if err != nil {
+		logging.GetLogger().Error("Failed to submit the missing drawings, trying again on the next load", logging.Fields{"group": game.GroupName, "error": err})
+	}
+	// Some of the drawings could have been submitted even if others failed
+	return true
+}
+
+// submitMissingDrawings submits the latest draft, or a blank canvas, for everyone who hasn't submitted a drawing.
+// The deadline is kept if any of them fails, so the drawings that are still missing are tried again on the next load.
+func submitMissingDrawings(game *models.Game) error {
+	state := drawingsInProgressState{game: game}
+	var firstErr error
+	submittedAuthors := map[string]bool{}
+	for _, drawing := range game.Drawings {
+		submittedAuthors[drawing.Author] = true
+	}
+	for _, player := range game.Players {
+		if submittedAuthors[player.Name] {
+			continue
+		}
+		drawing, hasDraft := game.DraftDrawings[player.Name]
+		if !hasDraft {
+			var err error
+			drawing, err = blankDrawing(player.Name)
+			if err != nil {
+				logging.GetLogger().Error("Failed to create a blank drawing", logging.Fields{"group": game.GroupName, "player": player.Name, "error": err})
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
+		err := state.submitDrawing(drawing, nil)
+		if err != nil {
+			logging.GetLogger().Error("Failed to submit a drawing after the deadline", logging.Fields{"group": game.GroupName, "player": player.Name, "error": err})
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	game.DrawingDeadline = nil
+	return nil
+}
+
+func blankDrawing(playerName string) (*models.Drawing, error) {
+	// Rasterizing no strokes at all gives an empty canvas
+	blankImage, err := images.RasterizeStrokes(nil)
+	if err != nil {
+		return nil, err
+	}
+	imageID, thumbnailID, err := storeDrawingImage(blankImage)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Drawing{Author: playerName, ImageID: imageID, ThumbnailID: thumbnailID}, nil
+}
@@ -2,7 +2,6 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 )
 
 type drawingsInProgressState struct {
@@ -14,40 +13,78 @@ func (state drawingsInProgressState) addPlayer(player *models.Player) error {
 	if state.game.IsPlayerInGame(player.Name) {
 		return nil
 	}
-	return errors.New("Cannot add new players to a game in this state")
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game in this state")
 }
 
 func (state drawingsInProgressState) startGame(groupName string, playerName string) error {
-	return errors.New("startGame not supported for drawingsInProgress state")
+	return newError(ErrorCodeWrongState, "startGame not supported for drawingsInProgress state")
 }
 
-func (state drawingsInProgressState) submitDrawing(playerName string, encodedImage string) error {
+func (state drawingsInProgressState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
 	for _, currentDrawing := range state.game.Drawings {
-		if currentDrawing.Author == playerName {
-			return errors.New("player has already submitted a drawing")
+		if currentDrawing.Author == drawing.Author {
+			return ErrDrawingAlreadySubmitted
 		}
 	}
-	player := state.game.GetPlayer(playerName)
+	player := state.game.GetPlayer(drawing.Author)
 	if player == nil {
-		return errors.New("player is not in the group")
+		return ErrPlayerNotInGame
 	}
-	drawing := models.Drawing{
-		OriginalPrompt: player.AssignedPrompt,
-		Author:         playerName,
-		ImageData:      encodedImage,
-		DecoyPrompts:   map[string]*models.Prompt{},
-		Votes:          map[string]*models.Vote{},
+	if pngImage != nil {
+		var err error
+		drawing.ImageID, drawing.ThumbnailID, err = storeDrawingImage(pngImage)
+		if err != nil {
+			return err
+		}
+	}
+	drawing.OriginalPrompt = player.AssignedPrompt
+	drawing.DecoyPrompts = map[string]*models.Prompt{}
+	drawing.Votes = map[string]*models.Vote{}
+	storeDrawingTimelapse(state.game, drawing)
+	state.game.Drawings = append(state.game.Drawings, drawing)
+	draft, hasDraft := state.game.DraftDrawings[drawing.Author]
+	delete(state.game.DraftDrawings, drawing.Author)
+	if hasDraft {
+		deleteDraftImages(state.game, draft)
 	}
-	state.game.Drawings = append(state.game.Drawings, &drawing)
 	// If this is the last drawing, transition to the fake prompt creation state
 	if len(state.game.Drawings) == len(state.game.Players) {
 		state.game.CurrentState = models.DecoyPromptCreation
+		stopDrawingTimer(state.game)
+	}
+	return nil
+}
+
+func (state drawingsInProgressState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	for _, currentDrawing := range state.game.Drawings {
+		if currentDrawing.Author == drawing.Author {
+			return ErrDrawingAlreadySubmitted
+		}
+	}
+	if !state.game.IsPlayerInGame(drawing.Author) {
+		return ErrPlayerNotInGame
+	}
+	if pngImage != nil {
+		var err error
+		drawing.ImageID, drawing.ThumbnailID, err = storeDrawingImage(pngImage)
+		if err != nil {
+			return err
+		}
+	}
+	if state.game.DraftDrawings == nil {
+		state.game.DraftDrawings = map[string]*models.Drawing{}
+	}
+	// Only the latest draft is kept, along with its images
+	previousDraft, hasPreviousDraft := state.game.DraftDrawings[drawing.Author]
+	state.game.DraftDrawings[drawing.Author] = drawing
+	if hasPreviousDraft {
+		deleteDraftImages(state.game, previousDraft)
 	}
 	return nil
 }
 
 func (state drawingsInProgressState) addPrompt(prompts *models.Prompt) error {
-	return errors.New("addprompts not supported for drawing state")
+	return newError(ErrorCodeWrongState, "addprompts not supported for drawing state")
 }
 
 func (state drawingsInProgressState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
@@ -63,6 +100,16 @@ func (state drawingsInProgressState) addGameStatusPropertiesForPlayer(player *mo
 			gameStatus.CurrentPlayer.HasCompletedAction = hasDrawing
 		}
 	}
+	// Players get their draft back so a reload doesn't lose their work
+	draft, hasDraft := state.game.DraftDrawings[player.Name]
+	if hasDraft && !gameStatus.CurrentPlayer.HasCompletedAction {
+		gameStatus.CurrentPlayer.DraftDrawing = &Drawing{
+			Author:       draft.Author,
+			ImageURL:     drawingImageURL(draft.ImageID),
+			ThumbnailURL: drawingThumbnailURL(draft),
+			Strokes:      makeResponseStrokesFromModelStrokes(draft.Strokes),
+		}
+	}
 	if player.AssignedPrompt != nil {
 		gameStatus.CurrentPlayer.AssignedPrompt = &Prompt{
 			Adjectives: player.AssignedPrompt.Adjectives,
@@ -73,5 +120,10 @@ func (state drawingsInProgressState) addGameStatusPropertiesForPlayer(player *mo
 }
 
 func (state drawingsInProgressState) castVote(player *models.Player, promptIdentifier string) error {
-	return errors.New("Casting votes is not allowed at this stage of the game")
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed at this stage of the game")
+}
+
+func (state drawingsInProgressState) advance() error {
+	// Missing drawings get submitted like they are when the drawing time runs out
+	return submitMissingDrawings(state.game)
 }
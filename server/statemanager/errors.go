@@ -0,0 +1,89 @@
+package statemanager
+
+import (
+	"drawydraw/archive"
+	"drawydraw/images"
+)
+
+// ErrorCode is a stable, machine readable name for a kind of failure that clients can branch on
+type ErrorCode string
+
+const (
+	// ErrorCodeGameNotFound - There's no game for the group
+	ErrorCodeGameNotFound ErrorCode = "GAME_NOT_FOUND"
+	// ErrorCodeGroupAlreadyExists - A game was created for a group that already has one
+	ErrorCodeGroupAlreadyExists ErrorCode = "GROUP_ALREADY_EXISTS"
+	// ErrorCodePlayerNotInGame - The player making the request hasn't joined the game
+	ErrorCodePlayerNotInGame ErrorCode = "PLAYER_NOT_IN_GAME"
+	// ErrorCodeNotHost - Only the host can do what was requested
+	ErrorCodeNotHost ErrorCode = "NOT_HOST"
+	// ErrorCodeHostAlreadyExists - A second host was added to a game
+	ErrorCodeHostAlreadyExists ErrorCode = "HOST_ALREADY_EXISTS"
+	// ErrorCodeWrongState - The action isn't allowed in the game's current state
+	ErrorCodeWrongState ErrorCode = "WRONG_STATE"
+	// ErrorCodeGamePaused - The game is paused so nothing can be done until the host resumes it
+	ErrorCodeGamePaused ErrorCode = "GAME_PAUSED"
+	// ErrorCodeAlreadySubmitted - The player already submitted their prompt or drawing for this phase
+	ErrorCodeAlreadySubmitted ErrorCode = "ALREADY_SUBMITTED"
+	// ErrorCodeNotEnoughPlayers - The game can't start without more players
+	ErrorCodeNotEnoughPlayers ErrorCode = "NOT_ENOUGH_PLAYERS"
+	// ErrorCodeVotedForOwnDecoy - The player voted for the decoy prompt they wrote
+	ErrorCodeVotedForOwnDecoy ErrorCode = "VOTED_FOR_OWN_DECOY"
+	// ErrorCodeInvalidInput - Something the player sent is missing or malformed
+	ErrorCodeInvalidInput ErrorCode = "INVALID_INPUT"
+	// ErrorCodeDrawingNotFound - There's no drawing or image with that identifier
+	ErrorCodeDrawingNotFound ErrorCode = "DRAWING_NOT_FOUND"
+	// ErrorCodeTranscriptNotFound - There's no archived game with that identifier
+	ErrorCodeTranscriptNotFound ErrorCode = "TRANSCRIPT_NOT_FOUND"
+	// ErrorCodeNoTimelapse - The drawing wasn't submitted as strokes so it can't be replayed
+	ErrorCodeNoTimelapse ErrorCode = "NO_TIMELAPSE"
+	// ErrorCodeInvalidRequest - The request itself couldn't be read
+	ErrorCodeInvalidRequest ErrorCode = "INVALID_REQUEST"
+	// ErrorCodeUnauthorized - The request needs credentials it didn't have, like the admin token
+	ErrorCodeUnauthorized ErrorCode = "UNAUTHORIZED"
+	// ErrorCodeInternal - Something unexpected went wrong
+	ErrorCodeInternal ErrorCode = "INTERNAL_ERROR"
+)
+
+// Error is a failure of a game action along with the code that describes it
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+func newError(code ErrorCode, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Errors that are returned in the same way from more than one place
+var (
+	// ErrGameNotFound is returned when there's no game for a group
+	ErrGameNotFound = newError(ErrorCodeGameNotFound, "Could not find a group with that name")
+	// ErrPlayerNotInGame is returned when a player acts on a game they haven't joined
+	ErrPlayerNotInGame = newError(ErrorCodePlayerNotInGame, "Player is not in the game")
+	// ErrDrawingAlreadySubmitted is returned when a player submits or saves a drawing after submitting one
+	ErrDrawingAlreadySubmitted = newError(ErrorCodeAlreadySubmitted, "player has already submitted a drawing")
+)
+
+// ErrorCodeOf returns the code for an error returned by the statemanager.
+// Errors from the archive and the drawing store get the code that matches them, anything else is internal.
+func ErrorCodeOf(err error) ErrorCode {
+	if gameError, isGameError := err.(*Error); isGameError {
+		return gameError.Code
+	}
+	switch err {
+	case archive.ErrTranscriptNotFound:
+		return ErrorCodeTranscriptNotFound
+	case images.ErrDrawingNotFound:
+		return ErrorCodeDrawingNotFound
+	case images.ErrInvalidDataURL, images.ErrUnsupportedImageFormat, images.ErrImageTooLarge,
+		images.ErrImageDimensionsTooLarge, images.ErrCorruptImage, images.ErrNoStrokes, images.ErrTooManyStrokes,
+		images.ErrInvalidStroke, images.ErrInvalidStrokeTiming, images.ErrInvalidTimelapseOptions:
+		return ErrorCodeInvalidInput
+	}
+	return ErrorCodeInternal
+}
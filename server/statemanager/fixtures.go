@@ -0,0 +1,160 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/images"
+	"drawydraw/models"
+	"fmt"
+	"time"
+)
+
+// Words the players of a fixture write their prompts and decoys with, there's one of each for every player a fixture can have
+var (
+	fixtureNouns      = []string{"cat", "tuna", "boat", "chicken", "cactus", "piano", "robot", "volcano", "teapot", "dragon", "bicycle", "octopus"}
+	fixtureDecoyNouns = []string{"dog", "salmon", "train", "duck", "tree", "guitar", "alien", "mountain", "kettle", "unicorn", "scooter", "squid"}
+	fixtureAdjectives = []string{"snazzy", "portly", "big", "majestic", "elegant", "sharp", "fluffy", "sleepy", "shiny", "grumpy", "tiny", "spicy"}
+)
+
+// MaxFixturePlayers is how many players a fixture can have
+var MaxFixturePlayers = len(fixtureNouns)
+
+// LoadFixture replaces a group's game with a new one in the given state, so the client can be tried out in any state
+// without playing up to it. The players play by the rules up to that state with made up prompts, scribbled drawings
+// and votes, the first one is the host. Games loaded in the game over state are a single round long.
+// Fixtures are kept out of the game archive, even once they're played on.
+func LoadFixture(ctx context.Context, groupName string, gameState models.GameState, playerNames []string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "LoadFixture", groupName, "")
+	defer operation.end(&err)
+	if len(playerNames) < 3 {
+		return nil, newError(ErrorCodeNotEnoughPlayers, "3 is the minimum number of players to play the game")
+	}
+	if len(playerNames) > MaxFixturePlayers {
+		return nil, newError(ErrorCodeInvalidInput, fmt.Sprintf("fixtures can have at most %d players", MaxFixturePlayers))
+	}
+	if _, err := getActiveState(&models.Game{CurrentState: gameState}); err != nil {
+		return nil, newError(ErrorCodeInvalidInput, fmt.Sprintf("%s is not a game state", gameState))
+	}
+	game := &models.Game{
+		ID:           models.NewGameID(),
+		StartedAt:    time.Now(),
+		GroupName:    groupName,
+		CurrentState: models.WaitingForPlayers,
+		Fixture:      true,
+	}
+	if gameState == models.GameOver {
+		game.Settings.RoundCount = 1
+	}
+	for i, playerName := range playerNames {
+		if game.IsPlayerInGame(playerName) {
+			return nil, newError(ErrorCodeInvalidInput, fmt.Sprintf("%s is in the fixture more than once", playerName))
+		}
+		game.AddPlayer(&models.Player{Name: playerName, Host: i == 0})
+	}
+	for game.CurrentState != gameState {
+		err := playFixtureState(game)
+		if err != nil {
+			return nil, err
+		}
+	}
+	saveGame(ctx, game)
+	return gameStatusForPlayer(game, playerNames[0])
+}
+
+// Has every player do what the game's current state is waiting for
+func playFixtureState(game *models.Game) error {
+	currentState, err := getActiveState(game)
+	if err != nil {
+		return err
+	}
+	host := game.Players[0]
+	switch game.CurrentState {
+	case models.WaitingForPlayers, models.Scoring:
+		return currentState.startGame(game.GroupName, host.Name)
+	case models.InitialPromptCreation:
+		for i, player := range game.Players {
+			prompt := models.BuildPrompt(fixtureNouns[i], []string{fixtureAdjectives[i], fixtureAdjectives[(i+1)%len(game.Players)]}, player.Name)
+			err = currentState.addPrompt(prompt)
+			if err != nil {
+				return err
+			}
+		}
+	case models.DrawingsInProgress:
+		for i, player := range game.Players {
+			drawing, err := fixtureDrawing(player.Name, i)
+			if err != nil {
+				return err
+			}
+			err = currentState.submitDrawing(drawing, nil)
+			if err != nil {
+				return err
+			}
+		}
+	case models.DecoyPromptCreation:
+		activeDrawing := game.GetActiveDrawing()
+		for i, player := range game.Players {
+			if player.Name == activeDrawing.Author {
+				continue
+			}
+			decoy := models.BuildPrompt(fixtureDecoyNouns[i], activeDrawing.OriginalPrompt.Adjectives, player.Name)
+			err = currentState.addPrompt(decoy)
+			if err != nil {
+				return err
+			}
+		}
+	case models.Voting:
+		return castFixtureVotes(game, currentState)
+	default:
+		return newError(ErrorCodeWrongState, fmt.Sprintf("can't play a fixture past the %s state", game.CurrentState))
+	}
+	return nil
+}
+
+// Every other player gets fooled by the decoy of the player after them, the rest find the original prompt
+func castFixtureVotes(game *models.Game, currentState state) error {
+	activeDrawing := game.GetActiveDrawing()
+	voters := []*models.Player{}
+	for _, player := range game.Players {
+		if player.Name != activeDrawing.Author {
+			voters = append(voters, player)
+		}
+	}
+	for i, voter := range voters {
+		selectedPrompt := activeDrawing.OriginalPrompt
+		if i%2 == 1 {
+			selectedPrompt = activeDrawing.DecoyPrompts[voters[(i+1)%len(voters)].Name]
+		}
+		err := currentState.castVote(voter, selectedPrompt.Identifier)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Fixture drawings are a few strokes that are different for each player, so they can be told apart and replayed
+func fixtureDrawing(playerName string, playerIndex int) (*models.Drawing, error) {
+	colors := []string{"#e6194b", "#3cb44b", "#4363d8", "#f58231"}
+	offset := float64(playerIndex * 20)
+	strokes := []*models.Stroke{}
+	for i, color := range colors {
+		start := float64(i * 60)
+		strokes = append(strokes, &models.Stroke{
+			Color: color,
+			Width: 6,
+			Points: []models.StrokePoint{
+				{X: 40 + start, Y: 40 + offset, Time: int64(i * 400)},
+				{X: 80 + start, Y: 120 + offset, Time: int64(i*400 + 200)},
+				{X: 120 + start, Y: 60 + offset, Time: int64(i*400 + 350)},
+			},
+		})
+	}
+	pngImage, err := images.RasterizeStrokes(strokes)
+	if err != nil {
+		return nil, err
+	}
+	imageID, thumbnailID, err := storeDrawingImage(pngImage)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Drawing{Author: playerName, ImageID: imageID, ThumbnailID: thumbnailID, Strokes: strokes}, nil
+}
@@ -0,0 +1,59 @@
+package statemanager
+
+import (
+	"drawydraw/models"
+)
+
+type gameOverState struct {
+	game *models.Game
+}
+
+func (state gameOverState) addPlayer(player *models.Player) error {
+	// Only allow existing players to rejoin the game and in that case, no-op
+	if state.game.IsPlayerInGame(player.Name) {
+		return nil
+	}
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game that is over")
+}
+
+func (state gameOverState) startGame(groupName string, playerName string) error {
+	return newError(ErrorCodeWrongState, "The game is over, start a rematch to play again")
+}
+
+func (state gameOverState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Submitting drawings is not allowed once the game is over")
+}
+
+func (state gameOverState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
+}
+
+func (state gameOverState) addPrompt(prompts *models.Prompt) error {
+	return newError(ErrorCodeWrongState, "addprompts not supported for game over state")
+}
+
+func (state gameOverState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
+	// Show every drawing from the last round along with the final scores
+	gameStatus.PastDrawings = make([]*Drawing, 0, len(state.game.Drawings))
+	for _, drawing := range state.game.Drawings {
+		gameStatus.PastDrawings = append(gameStatus.PastDrawings, gameStatusDrawingFromDrawing(drawing))
+	}
+	pointStandings := map[string]*PointStanding{}
+	for _, p := range state.game.Players {
+		pointStandings[p.Name] = &PointStanding{
+			Player:               p.Name,
+			RoundPointsBreakdown: []*PointsBreakdown{},
+			TotalScore:           p.Points,
+		}
+	}
+	gameStatus.PointStandings = &pointStandings
+	return nil
+}
+
+func (state gameOverState) castVote(player *models.Player, promptIdentifier string) error {
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed once the game is over")
+}
+
+func (state gameOverState) advance() error {
+	return newError(ErrorCodeWrongState, "the game is over, reset it to play again")
+}
@@ -0,0 +1,107 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/logging"
+	"drawydraw/metrics"
+	"drawydraw/models"
+	"time"
+)
+
+// Operations that only read games are logged at the debug level since clients poll some of them
+var readOnlyOperations = map[string]bool{
+	"GetGameState":        true,
+	"GetGame":             true,
+	"GetGameHistory":      true,
+	"GetGameTranscript":   true,
+	"GetDrawingImage":     true,
+	"GetDrawingTimelapse": true,
+}
+
+// operation is an entry point that's running, it's passed along in the context so the state of the game it loads
+// or saves can be logged without loading the game again
+type operation struct {
+	ctx        context.Context
+	name       string
+	groupName  string
+	playerName string
+	start      time.Time
+	state      models.GameState
+	unlock     func()
+}
+
+type operationKey struct{}
+
+// startOperation starts recording an entry point, defer end with a pointer to the entry point's error.
+// Operations on a group hold its lock until they end, so requests for the same game take turns changing it.
+func startOperation(ctx context.Context, name string, groupName string, playerName string) (context.Context, *operation) {
+	operation := &operation{ctx: ctx, name: name, groupName: groupName, playerName: playerName, start: time.Now()}
+	if groupName != "" {
+		operation.unlock = models.LockGroup(groupName)
+	}
+	return context.WithValue(ctx, operationKey{}, operation), operation
+}
+
+// recordState remembers the state a game was loaded or saved in for the operation running in a context, if there's one
+func recordState(ctx context.Context, game *models.Game) {
+	if operation, isOperation := ctx.Value(operationKey{}).(*operation); isOperation {
+		operation.state = game.CurrentState
+	}
+}
+
+// unlockGroup lets other requests for the group go ahead before the operation ends, once it's done with the game
+func (operation *operation) unlockGroup() {
+	if operation.unlock != nil {
+		operation.unlock()
+	}
+}
+
+// end records and logs how the operation went. Rejected actions are logged as warnings and unexpected failures as errors.
+func (operation *operation) end(err *error) {
+	operation.unlockGroup()
+	duration := time.Since(operation.start)
+	code := "OK"
+	level := logging.LevelInfo
+	if readOnlyOperations[operation.name] {
+		level = logging.LevelDebug
+	}
+	fields := logging.Fields{
+		"operation":  operation.name,
+		"group":      operation.groupName,
+		"player":     operation.playerName,
+		"state":      string(operation.state),
+		"durationMs": float64(duration.Microseconds()) / 1000,
+	}
+	if *err != nil {
+		code = string(ErrorCodeOf(*err))
+		fields["code"] = code
+		fields["error"] = *err
+		level = logging.LevelWarn
+		if code == string(ErrorCodeInternal) {
+			level = logging.LevelError
+		}
+	}
+	metrics.ObserveOperation(operation.name, code, duration)
+	logging.FromContext(operation.ctx).Log(level, "Game operation", fields)
+}
+
+// trackPhase records and logs a game moving to another state since it was last saved.
+// Only the state it ends up in is seen when it goes through more than one between saves.
+func trackPhase(ctx context.Context, game *models.Game) {
+	if game.Phase == game.CurrentState {
+		return
+	}
+	now := time.Now()
+	if game.Phase != "" {
+		timeInPhase := now.Sub(game.PhaseStartedAt)
+		metrics.ObserveTransition(string(game.Phase), string(game.CurrentState), timeInPhase)
+		logging.FromContext(ctx).Info("Game state changed", logging.Fields{
+			"group":        game.GroupName,
+			"from":         string(game.Phase),
+			"state":        string(game.CurrentState),
+			"phaseSeconds": timeInPhase.Seconds(),
+		})
+	}
+	game.Phase = game.CurrentState
+	game.PhaseStartedAt = now
+}
@@ -0,0 +1,51 @@
+package statemanager
+
+import (
+	"drawydraw/models"
+)
+
+// ErrGamePaused is returned when a player tries to act on a game the host has paused
+var ErrGamePaused = newError(ErrorCodeGamePaused, "The game is paused, wait for the host to resume it")
+
+// pausedState wraps the state a game was in when the host paused it
+type pausedState struct {
+	game        *models.Game
+	activeState state
+}
+
+func (state pausedState) addPlayer(player *models.Player) error {
+	// Existing players can still rejoin a paused game (e.g. after reloading)
+	if state.game.IsPlayerInGame(player.Name) {
+		return nil
+	}
+	return ErrGamePaused
+}
+
+func (state pausedState) startGame(groupName string, playerName string) error {
+	return ErrGamePaused
+}
+
+func (state pausedState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return ErrGamePaused
+}
+
+func (state pausedState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return ErrGamePaused
+}
+
+func (state pausedState) addPrompt(prompt *models.Prompt) error {
+	return ErrGamePaused
+}
+
+func (state pausedState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
+	// Players still see everything from the state the game was paused in
+	return state.activeState.addGameStatusPropertiesForPlayer(player, gameStatus)
+}
+
+func (state pausedState) castVote(player *models.Player, promptIdentifier string) error {
+	return ErrGamePaused
+}
+
+func (state pausedState) advance() error {
+	return ErrGamePaused
+}
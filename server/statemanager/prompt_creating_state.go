@@ -2,7 +2,6 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 	"math/rand"
 	"time"
 )
@@ -16,18 +15,18 @@ func (state promptCreatingState) addPlayer(player *models.Player) error {
 	if state.game.IsPlayerInGame(player.Name) {
 		return nil
 	}
-	return errors.New("Cannot add new players to a game in this state")
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game in this state")
 }
 
 func (state promptCreatingState) startGame(groupName string, playerName string) error {
-	return errors.New("startGame not supported for initial prompt creation state")
+	return newError(ErrorCodeWrongState, "startGame not supported for initial prompt creation state")
 }
 
 func (state promptCreatingState) addPrompt(prompt *models.Prompt) error {
 	//check if the player had already entered a prompt (not sure if needed)
 	for _, p := range state.game.OriginalPrompts {
 		if prompt.Author == p.Author {
-			return errors.New("The player has already entered their prompt")
+			return newError(ErrorCodeAlreadySubmitted, "The player has already entered their prompt")
 		}
 	}
 
@@ -36,12 +35,17 @@ func (state promptCreatingState) addPrompt(prompt *models.Prompt) error {
 	if len(state.game.OriginalPrompts) == len(state.game.Players) {
 		state.game.CurrentState = models.DrawingsInProgress
 		generatePrompts(state.game)
+		startDrawingTimer(state.game)
 	}
 	return nil
 }
 
-func (state promptCreatingState) submitDrawing(playerName string, encodedImage string) error {
-	return errors.New("Submitting drawings is not allowed in the initial prompt creation state")
+func (state promptCreatingState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Submitting drawings is not allowed in the initial prompt creation state")
+}
+
+func (state promptCreatingState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
 }
 
 func generatePrompts(game *models.Game) {
@@ -95,5 +99,9 @@ func (state promptCreatingState) addGameStatusPropertiesForPlayer(player *models
 }
 
 func (state promptCreatingState) castVote(player *models.Player, promptIdentifier string) error {
-	return errors.New("Casting votes is not allowed at this stage of the game")
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed at this stage of the game")
+}
+
+func (state promptCreatingState) advance() error {
+	return newError(ErrorCodeWrongState, "a game can't be advanced until every player has written a prompt")
 }
@@ -2,7 +2,6 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 	"fmt"
 )
 
@@ -15,20 +14,20 @@ func (state scoringState) addPlayer(player *models.Player) error {
 	if state.game.IsPlayerInGame(player.Name) {
 		return nil
 	}
-	return errors.New("Cannot add new players to a game in this state")
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game in this state")
 }
 
 func (state scoringState) startGame(groupName string, playerName string) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("Could not find active drawing for game")
+		return newError(ErrorCodeWrongState, "Could not find active drawing for game")
 	}
 	// Calculate standings and update total points
 	standings := state.calculateStandings(activeDrawing, state.game)
 	for name, standing := range *standings {
 		player := state.game.GetPlayer(name)
 		if player == nil {
-			return fmt.Errorf("Could not find player %s in the game", name)
+			return newError(ErrorCodePlayerNotInGame, fmt.Sprintf("Could not find player %s in the game", name))
 		}
 		player.Points = standing.TotalScore
 	}
@@ -37,34 +36,48 @@ func (state scoringState) startGame(groupName string, playerName string) error {
 	if state.game.GetActiveDrawing() != nil {
 		// If there's another active drawing, go to the decoy prompts state
 		state.game.CurrentState = models.DecoyPromptCreation
-	} else {
-		// If there isn't, then we need to reset prompts/drawings and go to prompts
-		state.game.OriginalPrompts = []*models.Prompt{}
-		state.game.GeneratedPrompts = []*models.Prompt{}
-		state.game.Drawings = []*models.Drawing{}
-		// Also clear out assigned prompts
-		for _, player := range state.game.Players {
-			player.AssignedPrompt = nil
-		}
-		state.game.CurrentState = models.InitialPromptCreation
+		return nil
+	}
+	state.game.CompletedRounds++
+	if state.game.Settings.RoundCount > 0 && state.game.CompletedRounds >= state.game.Settings.RoundCount {
+		// If that was the last round the game is over, drawings are kept around for the final screen
+		state.game.CurrentState = models.GameOver
+		archiveRound(state.game)
+		return nil
 	}
+	archiveRound(state.game)
+	// Otherwise start a new round, we need to reset prompts/drawings and go to prompts
+	state.game.OriginalPrompts = []*models.Prompt{}
+	state.game.GeneratedPrompts = []*models.Prompt{}
+	state.game.Drawings = []*models.Drawing{}
+	// Also clear out assigned prompts
+	for _, player := range state.game.Players {
+		player.AssignedPrompt = nil
+	}
+	state.game.CurrentState = models.InitialPromptCreation
 	return nil
 }
 
-func (state scoringState) submitDrawing(playerName string, encodedImage string) error {
-	return errors.New("Submitting drawings is not allowed in the voting state")
+func (state scoringState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Submitting drawings is not allowed in the voting state")
+}
+
+func (state scoringState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
 }
 
 func (state scoringState) addPrompt(prompts *models.Prompt) error {
-	return errors.New("addprompts not supported for voting state")
+	return newError(ErrorCodeWrongState, "addprompts not supported for voting state")
 }
 
 func (state scoringState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("Could not find active drawing for game")
+		return newError(ErrorCodeWrongState, "Could not find active drawing for game")
 	}
 	gameStatus.CurrentDrawing = gameStatusDrawingFromDrawing(activeDrawing)
+	// Only the current drawing carries its strokes so it can be replayed, past drawings stay light
+	gameStatus.CurrentDrawing.Strokes = makeResponseStrokesFromModelStrokes(activeDrawing.Strokes)
 	// Add drawings that have been scored to past drawings
 	gameStatus.PastDrawings = make([]*Drawing, 0, len(state.game.Drawings))
 	for _, drawing := range state.game.Drawings {
@@ -80,17 +93,32 @@ func (state scoringState) addGameStatusPropertiesForPlayer(player *models.Player
 }
 
 func (state scoringState) castVote(player *models.Player, promptIdentifier string) error {
-	return errors.New("Casting votes is not allowed at this stage of the game")
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed at this stage of the game")
 }
 
 func gameStatusDrawingFromDrawing(drawing *models.Drawing) *Drawing {
 	return &Drawing{
 		Author:         drawing.Author,
-		ImageData:      drawing.ImageData,
+		ImageURL:       drawingImageURL(drawing.ImageID),
+		ThumbnailURL:   drawingThumbnailURL(drawing),
 		OriginalPrompt: makeResponsePromptFromModelPrompt(drawing.OriginalPrompt),
 	}
 }
 
+func makeResponseStrokesFromModelStrokes(modelStrokes []*models.Stroke) []*Stroke {
+	if modelStrokes == nil {
+		return nil
+	}
+	strokes := make([]*Stroke, len(modelStrokes))
+	for i, stroke := range modelStrokes {
+		strokes[i] = &Stroke{Color: stroke.Color, Width: stroke.Width, Points: make([]StrokePoint, len(stroke.Points))}
+		for j, point := range stroke.Points {
+			strokes[i].Points[j] = StrokePoint(point)
+		}
+	}
+	return strokes
+}
+
 func (state scoringState) calculateStandings(activeDrawing *models.Drawing, game *models.Game) *map[string]*PointStanding {
 	pointStandings := map[string]*PointStanding{}
 	// Initialize standings with the point totals before this round
@@ -116,7 +144,9 @@ func (state scoringState) calculateStandings(activeDrawing *models.Drawing, game
 				&PointsBreakdown{Amount: 1, Reason: OtherChosePromptDrawn, CausingPlayer: playerName},
 			)
 		} else if vote.SelectedPrompt.Author != vote.Player.Name {
-			// The person who fooled the voter earns 1 point as long as they didn't fool themselves
+			// The person who fooled the voter earns 1 point as long as they didn't fool themselves.
+			// Votes for your own decoy are only possible when the game's AllowSelfVotes setting is on
+			// and they're worth nothing to anyone.
 			pointStandings[vote.SelectedPrompt.Author].TotalScore += 1
 			pointStandings[vote.SelectedPrompt.Author].RoundPointsBreakdown = append(
 				pointStandings[vote.SelectedPrompt.Author].RoundPointsBreakdown,
@@ -126,3 +156,7 @@ func (state scoringState) calculateStandings(activeDrawing *models.Drawing, game
 	}
 	return &pointStandings
 }
+
+func (state scoringState) advance() error {
+	return state.startGame(state.game.GroupName, "")
+}
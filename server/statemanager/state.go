@@ -9,7 +9,12 @@ type state interface {
 	addPlayer(player *models.Player) error
 	addPrompt(prompt *models.Prompt) error
 	startGame(groupName string, playerName string) error
-	submitDrawing(playerName string, encodedImage string) error
+	// submitDrawing and saveDraftDrawing store pngImage as the drawing's image once the drawing is accepted,
+	// it's nil when that's been done already
+	submitDrawing(drawing *models.Drawing, pngImage []byte) error
+	saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error
 	castVote(player *models.Player, promptIdentifier string) error
 	addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error
+	// advance moves the game on as if the players who haven't acted yet had skipped their turn, operators use it for stuck games
+	advance() error
 }
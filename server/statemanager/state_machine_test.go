@@ -0,0 +1,207 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/models"
+	"drawydraw/test"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	stateMachineRuns  = 100
+	stateMachineSteps = 300
+)
+
+// The players actions are picked from, the last one never joins unless a random AddPlayer adds them
+var stateMachinePlayers = []string{"host", "alice", "bob", "carol", "dave", "mallory"}
+
+var stateMachineNouns = []string{"cat", "dog", "hat"}
+
+var validGameStates = map[models.GameState]bool{
+	models.WaitingForPlayers:     true,
+	models.InitialPromptCreation: true,
+	models.DrawingsInProgress:    true,
+	models.DecoyPromptCreation:   true,
+	models.Voting:                true,
+	models.Scoring:               true,
+	models.GameOver:              true,
+}
+
+// stateMachineRun issues random actions from random players on one game and checks the game is still valid after each of them
+type stateMachineRun struct {
+	t         *testing.T
+	random    *rand.Rand
+	seed      int64
+	groupName string
+	// points has the most points each player has had, since they should never go down
+	points map[string]uint64
+	// reached has every state the game has been in
+	reached map[models.GameState]bool
+}
+
+// TestStateMachine_RandomActions_KeepGameValid plays games with arbitrary sequences of actions, failures print the seed so they can be replayed
+func TestStateMachine_RandomActions_KeepGameValid(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	test.SetupTestArchiveStore(t)
+	reached := map[models.GameState]bool{}
+	for seed := int64(1); seed <= stateMachineRuns; seed++ {
+		run := &stateMachineRun{
+			t:         t,
+			random:    rand.New(rand.NewSource(seed)),
+			seed:      seed,
+			groupName: fmt.Sprintf("random %d", seed),
+			points:    map[string]uint64{},
+			reached:   reached,
+		}
+		if !run.play() {
+			return
+		}
+	}
+	// Random actions should get through every state, otherwise the invariants weren't checked for all of them
+	for state := range validGameStates {
+		assert.True(t, reached[state], "no random game reached %s", state)
+	}
+}
+
+func (run *stateMachineRun) play() bool {
+	settings := models.GameSettings{RoundCount: uint(1 + run.random.Intn(2)), AllowSelfVotes: run.random.Intn(2) == 0}
+	if !assert.NoError(run.t, CreateGroup(context.Background(), run.groupName, settings)) {
+		return false
+	}
+	for step := 0; step < stateMachineSteps; step++ {
+		action, err := run.step()
+		if err != nil && !assert.NotEqual(run.t, ErrorCodeInternal, ErrorCodeOf(err), "seed %d step %d: %s failed with an untyped error: %s", run.seed, step, action, err) {
+			return false
+		}
+		if !run.checkInvariants(fmt.Sprintf("seed %d step %d after %s", run.seed, step, action)) {
+			return false
+		}
+	}
+	return true
+}
+
+// step issues one random action and describes it, a panic fails the test with the seed and the action that caused it
+func (run *stateMachineRun) step() (action string, err error) {
+	playerName := stateMachinePlayers[run.random.Intn(len(stateMachinePlayers))]
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			run.t.Fatalf("seed %d: %s panicked: %v\n%s", run.seed, action, recovered, debug.Stack())
+		}
+	}()
+	switch run.pickAction() {
+	case 0:
+		action = fmt.Sprintf("AddPlayer(%s)", playerName)
+		_, err = AddPlayer(context.Background(), playerName, run.groupName, playerName == stateMachinePlayers[0])
+	case 1:
+		noun := stateMachineNouns[run.random.Intn(len(stateMachineNouns))]
+		action = fmt.Sprintf("AddPrompt(%s, %s)", playerName, noun)
+		_, err = AddPrompt(context.Background(), playerName, run.groupName, noun, "big", "red")
+	case 2:
+		action = fmt.Sprintf("SubmitDrawing(%s)", playerName)
+		_, err = SubmitDrawing(context.Background(), playerName, run.groupName, test.MockImageData)
+	case 3:
+		promptIdentifier := run.votablePromptIdentifier(playerName)
+		action = fmt.Sprintf("CastVote(%s, %s)", playerName, promptIdentifier)
+		_, err = CastVote(context.Background(), playerName, run.groupName, promptIdentifier)
+	default:
+		action = fmt.Sprintf("StartGame(%s)", playerName)
+		_, err = StartGame(context.Background(), run.groupName, playerName)
+	}
+	return action, err
+}
+
+// Actions that fit each state, so games get through every state in a reasonable number of steps
+var stateMachineFittingActions = map[models.GameState][]int{
+	models.WaitingForPlayers:     {0, 4},
+	models.InitialPromptCreation: {1},
+	models.DrawingsInProgress:    {2},
+	models.DecoyPromptCreation:   {1},
+	models.Voting:                {3},
+	models.Scoring:               {4},
+}
+
+// pickAction picks any action half the time and one that fits the game's state the other half
+func (run *stateMachineRun) pickAction() int {
+	game := models.GetGameProvider().LoadGame(run.groupName)
+	fittingActions := stateMachineFittingActions[game.CurrentState]
+	if len(fittingActions) == 0 || run.random.Intn(2) == 0 {
+		return run.random.Intn(5)
+	}
+	return fittingActions[run.random.Intn(len(fittingActions))]
+}
+
+// votablePromptIdentifier mostly picks one of the prompts the player is shown, and sometimes one that doesn't exist
+func (run *stateMachineRun) votablePromptIdentifier(playerName string) string {
+	gameStatus, err := GetGameState(context.Background(), run.groupName, playerName)
+	if err != nil || gameStatus.CurrentDrawing == nil || len(gameStatus.CurrentDrawing.Prompts) == 0 || run.random.Intn(10) == 0 {
+		return fmt.Sprint(run.random.Int63())
+	}
+	prompts := gameStatus.CurrentDrawing.Prompts
+	return prompts[run.random.Intn(len(prompts))].Identifier
+}
+
+func (run *stateMachineRun) checkInvariants(step string) bool {
+	t := run.t
+	game := models.GetGameProvider().LoadGame(run.groupName)
+	if !assert.NotNil(t, game, step) {
+		return false
+	}
+	valid := assert.True(t, validGameStates[game.CurrentState], "%s: unknown state %s", step, game.CurrentState)
+	run.reached[game.CurrentState] = true
+	_, err := getCurrentState(game)
+	valid = assert.NoError(t, err, step) && valid
+	switch game.CurrentState {
+	case models.DecoyPromptCreation, models.Voting, models.Scoring:
+		valid = assert.NotNil(t, game.GetActiveDrawing(), "%s: %s without an active drawing", step, game.CurrentState) && valid
+	}
+
+	hostCount := 0
+	names := map[string]bool{}
+	for _, player := range game.Players {
+		valid = assert.False(t, names[player.Name], "%s: %s joined twice", step, player.Name) && valid
+		names[player.Name] = true
+		if player.Host {
+			hostCount++
+		}
+		valid = assert.True(t, player.Points >= run.points[player.Name], "%s: %s went from %d to %d points", step, player.Name, run.points[player.Name], player.Points) && valid
+		run.points[player.Name] = player.Points
+		_, err := GetGameState(context.Background(), run.groupName, player.Name)
+		valid = assert.NoError(t, err, "%s: could not get the game status for %s", step, player.Name) && valid
+	}
+	if len(game.Players) > 0 {
+		valid = assert.Equal(t, 1, hostCount, "%s: the game has %d hosts", step, hostCount) && valid
+	}
+
+	for _, drawing := range game.Drawings {
+		voters := map[string]bool{}
+		for key, vote := range drawing.Votes {
+			valid = assert.Equal(t, key, vote.Player.Name, "%s: a vote by %s is kept as %s's", step, vote.Player.Name, key) && valid
+			valid = assert.False(t, voters[vote.Player.Name], "%s: %s voted twice for %s's drawing", step, vote.Player.Name, drawing.Author) && valid
+			voters[vote.Player.Name] = true
+			valid = assert.NotEqual(t, drawing.Author, vote.Player.Name, "%s: %s voted for their own drawing", step, drawing.Author) && valid
+			valid = assert.True(t, isPromptOfDrawing(drawing, vote.SelectedPrompt), "%s: %s voted for a prompt that isn't on the drawing", step, vote.Player.Name) && valid
+		}
+		for author := range drawing.DecoyPrompts {
+			valid = assert.NotEqual(t, drawing.Author, author, "%s: %s wrote a decoy for their own drawing", step, author) && valid
+		}
+	}
+	return valid
+}
+
+func isPromptOfDrawing(drawing *models.Drawing, prompt *models.Prompt) bool {
+	if prompt == drawing.OriginalPrompt {
+		return true
+	}
+	for _, decoyPrompt := range drawing.DecoyPrompts {
+		if prompt == decoyPrompt {
+			return true
+		}
+	}
+	return false
+}
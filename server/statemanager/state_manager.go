@@ -1,10 +1,19 @@
 package statemanager
 
 import (
+	"context"
+	"drawydraw/archive"
+	"drawydraw/images"
+	"drawydraw/localization"
+	"drawydraw/logging"
+	"drawydraw/metrics"
 	"drawydraw/models"
-	"drawydraw/test"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
 )
 
 // StateManager handles the different states and actions throughout the game
@@ -29,6 +38,7 @@ type Player struct {
 	Host             bool   `json:"host"`
 	Points           uint64 `json:"points"`
 	HasPendingAction bool   `json:"hasPendingAction"`
+	Bot              bool   `json:"bot"`
 }
 
 // CurrentPlayer represents the status of the player making the request
@@ -37,12 +47,31 @@ type CurrentPlayer struct {
 	IsHost             bool    `json:"isHost"`
 	Name               string  `json:"name"`
 	HasCompletedAction bool    `json:"hasCompletedAction"`
+	Language           string  `json:"language"`
+	// DraftDrawing is the drawing the player last autosaved, while they haven't submitted one
+	DraftDrawing *Drawing `json:"draftDrawing"`
+}
+
+// StrokePoint is a point in a stroke along with how many milliseconds into the drawing it was drawn
+type StrokePoint struct {
+	X    float64 `json:"x"`
+	Y    float64 `json:"y"`
+	Time int64   `json:"time"`
+}
+
+// Stroke is a line of a drawing that was submitted as strokes
+type Stroke struct {
+	Color  string        `json:"color"`
+	Width  float64       `json:"width"`
+	Points []StrokePoint `json:"points"`
 }
 
 // Drawing represents a drawing that players are either making prompts for or voting on prompts for it
 type Drawing struct {
 	Author         string    `json:"author"`
-	ImageData      string    `json:"imageData"`
+	ImageURL       string    `json:"imageUrl"`
+	ThumbnailURL   string    `json:"thumbnailUrl"`
+	Strokes        []*Stroke `json:"strokes"`
 	Prompts        []*Prompt `json:"prompts"`
 	OriginalPrompt *Prompt   `json:"originalPrompt"`
 }
@@ -78,32 +107,52 @@ type GameStatusResponse struct {
 	CurrentDrawing *Drawing                   `json:"currentDrawing"`
 	PointStandings *map[string]*PointStanding `json:"pointStandings"`
 	PastDrawings   []*Drawing                 `json:"pastDrawings"`
+	Paused         bool                       `json:"paused"`
+	PauseReason    string                     `json:"pauseReason"`
+	// DrawingDeadline is when drafts get submitted for the players who haven't submitted a drawing
+	DrawingDeadline *time.Time `json:"drawingDeadline"`
+	// BotTurnAt is when the next bot takes its turn, if any bot has one to take
+	BotTurnAt *time.Time `json:"botTurnAt"`
 }
 
+// DefaultRoundCount is how many rounds games created without a round count play, so they end and players can rematch
+const DefaultRoundCount = 3
+
 // CreateGroup Handles creating a group other players can join
-func CreateGroup(groupName string) error {
+func CreateGroup(ctx context.Context, groupName string, settings models.GameSettings) (err error) {
+	ctx, operation := startOperation(ctx, "CreateGroup", groupName, "")
+	defer operation.end(&err)
 	if len(groupName) < 1 {
-		return errors.New("no group name provided")
+		return newError(ErrorCodeInvalidInput, "no group name provided")
 	}
 	// See if there's already a game for that group name and error out if ther eis
 	gameState := models.GetGameProvider().LoadGame(groupName)
 	if gameState != nil {
-		return fmt.Errorf("group '%s' already exists", groupName)
+		return newError(ErrorCodeGroupAlreadyExists, fmt.Sprintf("group '%s' already exists", groupName))
+	}
+	if settings.RoundCount == 0 {
+		settings.RoundCount = DefaultRoundCount
 	}
 	// Games start in the waiting for players stage
 	gameState = &models.Game{
-		GroupName: groupName, CurrentState: models.WaitingForPlayers,
+		ID:           models.NewGameID(),
+		StartedAt:    time.Now(),
+		GroupName:    groupName,
+		CurrentState: models.WaitingForPlayers,
+		Settings:     settings,
 	}
-	models.GetGameProvider().SaveGame(gameState)
+	saveGame(ctx, gameState)
 	return nil
 }
 
 // AddPlayer Handles adding a player to a game
-func AddPlayer(playerName string, groupName string, isHost bool) (*GameStatusResponse, error) {
+func AddPlayer(ctx context.Context, playerName string, groupName string, isHost bool) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "AddPlayer", groupName, playerName)
+	defer operation.end(&err)
 	if len(playerName) < 1 {
-		return nil, errors.New("no player name provided")
+		return nil, newError(ErrorCodeInvalidInput, "no player name provided")
 	}
-	stateManager, err := getManagerForGroup(groupName)
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
@@ -112,14 +161,19 @@ func AddPlayer(playerName string, groupName string, isHost bool) (*GameStatusRes
 	if isHost {
 		if hostName != nil &&
 			playerName != *hostName {
-			return nil, fmt.Errorf("failed to add player %s as host - %s is already host", playerName, *hostName)
+			return nil, newError(ErrorCodeHostAlreadyExists, fmt.Sprintf("failed to add player %s as host - %s is already host", playerName, *hostName))
 		}
 	} else {
 		// Non-host player is joining a game without a host - this should not be possible
 		if hostName == nil {
-			return nil, errors.New("cannot add a non-host player to a game without a host")
+			return nil, newError(ErrorCodeWrongState, "cannot add a non-host player to a game without a host")
 		}
 	}
+	// Players rejoin by name, so nobody can join as one of the bots
+	existingPlayer := stateManager.game.GetPlayer(playerName)
+	if existingPlayer != nil && existingPlayer.Bot {
+		return nil, newError(ErrorCodeInvalidInput, fmt.Sprintf("%s is a bot", playerName))
+	}
 
 	// Add the group creator as the first player
 	player := models.Player{Name: playerName, Host: isHost}
@@ -127,7 +181,7 @@ func AddPlayer(playerName string, groupName string, isHost bool) (*GameStatusRes
 	if err != nil {
 		return nil, err
 	}
-	models.GetGameProvider().SaveGame(stateManager.game)
+	saveGame(ctx, stateManager.game)
 	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
@@ -136,18 +190,23 @@ func AddPlayer(playerName string, groupName string, isHost bool) (*GameStatusRes
 }
 
 // AddPrompt handles adding the prompt a player created to the game state
-func AddPrompt(playerName string, groupName string, noun string, adjective1 string, adjective2 string) (*GameStatusResponse, error) {
+func AddPrompt(ctx context.Context, playerName string, groupName string, noun string, adjective1 string, adjective2 string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "AddPrompt", groupName, playerName)
+	defer operation.end(&err)
 	//check if any of the prompt fields were empty
 	if len(noun) < 1 ||
 		len(adjective1) < 1 ||
 		len(adjective2) < 1 {
-		return nil, errors.New("Prompt is missing a field")
+		return nil, newError(ErrorCodeInvalidInput, "Prompt is missing a field")
 	}
 
-	stateManager, err := getManagerForGroup(groupName)
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
+	if stateManager.game.GetPlayer(playerName) == nil {
+		return nil, ErrPlayerNotInGame
+	}
 
 	newPrompt := models.BuildPrompt(noun, []string{adjective1, adjective2}, playerName)
 
@@ -155,7 +214,7 @@ func AddPrompt(playerName string, groupName string, noun string, adjective1 stri
 	if err != nil {
 		return nil, err
 	}
-	models.GetGameProvider().SaveGame(stateManager.game)
+	saveGame(ctx, stateManager.game)
 	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
@@ -164,22 +223,111 @@ func AddPrompt(playerName string, groupName string, noun string, adjective1 stri
 }
 
 // SubmitDrawing handles a player submitting a drawing
-func SubmitDrawing(playerName string, groupName string, imageData string) (*GameStatusResponse, error) {
+func SubmitDrawing(ctx context.Context, playerName string, groupName string, imageData string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "SubmitDrawing", groupName, playerName)
+	defer operation.end(&err)
+	normalizedImage, err := normalizeImageData(imageData)
+	if err != nil {
+		return nil, err
+	}
+	return submitDrawing(ctx, playerName, groupName, normalizedImage, nil)
+}
+
+// SubmitStrokeDrawing handles a player submitting a drawing as the strokes they drew.
+// The strokes are kept so the drawing can be replayed and are rasterized for clients that need an image.
+func SubmitStrokeDrawing(ctx context.Context, playerName string, groupName string, strokes []*Stroke) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "SubmitStrokeDrawing", groupName, playerName)
+	defer operation.end(&err)
+	modelStrokes, rasterizedImage, err := rasterizeStrokes(strokes)
+	if err != nil {
+		return nil, err
+	}
+	return submitDrawing(ctx, playerName, groupName, rasterizedImage, modelStrokes)
+}
+
+// SaveDraftDrawing handles a player autosaving the drawing they're working on.
+// Drafts are returned in the player's game status and submitted for them when the drawing time runs out.
+func SaveDraftDrawing(ctx context.Context, playerName string, groupName string, imageData string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "SaveDraftDrawing", groupName, playerName)
+	defer operation.end(&err)
+	normalizedImage, err := normalizeImageData(imageData)
+	if err != nil {
+		return nil, err
+	}
+	return saveDraftDrawing(ctx, playerName, groupName, normalizedImage, nil)
+}
+
+// SaveDraftStrokeDrawing handles a player autosaving the strokes they've drawn so far
+func SaveDraftStrokeDrawing(ctx context.Context, playerName string, groupName string, strokes []*Stroke) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "SaveDraftStrokeDrawing", groupName, playerName)
+	defer operation.end(&err)
+	modelStrokes, rasterizedImage, err := rasterizeStrokes(strokes)
+	if err != nil {
+		return nil, err
+	}
+	return saveDraftDrawing(ctx, playerName, groupName, rasterizedImage, modelStrokes)
+}
+
+func normalizeImageData(imageData string) ([]byte, error) {
 	//check if the image data is empty
 	if len(imageData) < 1 {
-		return nil, errors.New("Image data was not provided")
+		return nil, newError(ErrorCodeInvalidInput, "Image data was not provided")
+	}
+	// Only valid images within the configured limits make it into the game, always as PNGs
+	return images.NormalizeDrawing(imageData)
+}
+
+func rasterizeStrokes(strokes []*Stroke) ([]*models.Stroke, []byte, error) {
+	modelStrokes := make([]*models.Stroke, len(strokes))
+	for i, stroke := range strokes {
+		if stroke == nil {
+			continue
+		}
+		modelStrokes[i] = &models.Stroke{Color: stroke.Color, Width: stroke.Width}
+		for _, point := range stroke.Points {
+			modelStrokes[i].Points = append(modelStrokes[i].Points, models.StrokePoint(point))
+		}
+	}
+	err := images.ValidateStrokes(modelStrokes)
+	if err != nil {
+		return nil, nil, err
+	}
+	rasterizedImage, err := images.RasterizeStrokes(modelStrokes)
+	if err != nil {
+		return nil, nil, err
 	}
+	return modelStrokes, rasterizedImage, nil
+}
 
-	stateManager, err := getManagerForGroup(groupName)
+func submitDrawing(ctx context.Context, playerName string, groupName string, pngImage []byte, strokes []*models.Stroke) (*GameStatusResponse, error) {
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	drawing := &models.Drawing{Author: playerName, Strokes: strokes}
+	err = stateManager.currentState.submitDrawing(drawing, pngImage)
+	if err != nil {
+		return nil, err
+	}
+	saveGame(ctx, stateManager.game)
+	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
 	}
+	return gameStatus, nil
+}
 
-	err = stateManager.currentState.submitDrawing(playerName, imageData)
+func saveDraftDrawing(ctx context.Context, playerName string, groupName string, pngImage []byte, strokes []*models.Stroke) (*GameStatusResponse, error) {
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
-	models.GetGameProvider().SaveGame(stateManager.game)
+	drawing := &models.Drawing{Author: playerName, Strokes: strokes}
+	err = stateManager.currentState.saveDraftDrawing(drawing, pngImage)
+	if err != nil {
+		return nil, err
+	}
+	saveGame(ctx, stateManager.game)
 	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
@@ -187,23 +335,85 @@ func SubmitDrawing(playerName string, groupName string, imageData string) (*Game
 	return gameStatus, nil
 }
 
+// Images are stored separately so game status only needs to carry their URLs
+func storeDrawingImage(pngImage []byte) (string, string, error) {
+	metrics.ObserveDrawingSize(len(pngImage))
+	imageID, err := images.GetDrawingStore().SaveDrawing(&images.StoredDrawing{MediaType: "image/png", Data: pngImage})
+	if err != nil {
+		return "", "", err
+	}
+	thumbnail, err := images.CreateThumbnail(pngImage)
+	if err != nil {
+		return "", "", err
+	}
+	thumbnailID, err := images.GetDrawingStore().SaveDrawing(&images.StoredDrawing{MediaType: "image/png", Data: thumbnail})
+	if err != nil {
+		return "", "", err
+	}
+	return imageID, thumbnailID, nil
+}
+
+// deleteDraftImages deletes the images of a draft that was replaced or submitted, unless the game still shows them.
+// Images are stored by their contents, so a draft that didn't change since it was last saved shares them with the new one.
+func deleteDraftImages(game *models.Game, draft *models.Drawing) {
+	for _, imageID := range []string{draft.ImageID, draft.ThumbnailID} {
+		if imageID == "" || gameShowsImage(game, imageID) {
+			continue
+		}
+		err := images.GetDrawingStore().DeleteDrawing(imageID)
+		if err != nil {
+			logging.GetLogger().Error("Failed to delete a draft's image", logging.Fields{"group": game.GroupName, "player": draft.Author, "error": err})
+		}
+	}
+}
+
+func gameShowsImage(game *models.Game, imageID string) bool {
+	drawings := append([]*models.Drawing{}, game.Drawings...)
+	for _, draft := range game.DraftDrawings {
+		drawings = append(drawings, draft)
+	}
+	for _, drawing := range drawings {
+		if drawing.ImageID == imageID || drawing.ThumbnailID == imageID || drawing.TimelapseID == imageID {
+			return true
+		}
+	}
+	return false
+}
+
+// Timelapses take much longer to render than to serve, so drawings get theirs rendered once when they're submitted.
+// A drawing is still submitted if that fails, its timelapse is rendered whenever it's asked for instead.
+func storeDrawingTimelapse(game *models.Game, drawing *models.Drawing) {
+	if len(drawing.Strokes) == 0 {
+		return
+	}
+	timelapse, err := images.RenderTimelapse(drawing.Strokes, images.GetTimelapseOptions())
+	if err == nil {
+		drawing.TimelapseID, err = images.GetDrawingStore().SaveDrawing(&images.StoredDrawing{MediaType: "image/gif", Data: timelapse})
+	}
+	if err != nil {
+		logging.GetLogger().Error("Failed to store a drawing's timelapse", logging.Fields{"group": game.GroupName, "player": drawing.Author, "error": err})
+	}
+}
+
 // CastVote handles a player casting a vote for a prompt in a drawing
-func CastVote(playerName string, groupName string, promptIdentifier string) (*GameStatusResponse, error) {
-	stateManager, err := getManagerForGroup(groupName)
+func CastVote(ctx context.Context, playerName string, groupName string, promptIdentifier string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "CastVote", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
 
 	player := stateManager.game.GetPlayer(playerName)
 	if player == nil {
-		return nil, errors.New("Player is not in the game")
+		return nil, ErrPlayerNotInGame
 	}
 
 	err = stateManager.currentState.castVote(player, promptIdentifier)
 	if err != nil {
 		return nil, err
 	}
-	models.GetGameProvider().SaveGame(stateManager.game)
+	saveGame(ctx, stateManager.game)
 	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
@@ -212,8 +422,10 @@ func CastVote(playerName string, groupName string, promptIdentifier string) (*Ga
 }
 
 // GetGameState gets the current state for a given game and player
-func GetGameState(groupName string, playerName string) (*GameStatusResponse, error) {
-	stateManager, err := getManagerForGroup(groupName)
+func GetGameState(ctx context.Context, groupName string, playerName string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "GetGameState", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
@@ -225,8 +437,10 @@ func GetGameState(groupName string, playerName string) (*GameStatusResponse, err
 }
 
 // StartGame starts the game with the current players
-func StartGame(groupName string, playerName string) (*GameStatusResponse, error) {
-	stateManager, err := getManagerForGroup(groupName)
+func StartGame(ctx context.Context, groupName string, playerName string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "StartGame", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +449,59 @@ func StartGame(groupName string, playerName string) (*GameStatusResponse, error)
 	if err != nil {
 		return nil, err
 	}
-	models.GetGameProvider().SaveGame(stateManager.game)
+	saveGame(ctx, stateManager.game)
+	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
+	if err != nil {
+		return nil, err
+	}
+	return gameStatus, nil
+}
+
+// PauseGame lets the host freeze the game in its current state until they resume it
+func PauseGame(ctx context.Context, groupName string, playerName string, reason string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "PauseGame", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	hostName := stateManager.game.GetHostName()
+	if hostName == nil || playerName != *hostName {
+		return nil, newError(ErrorCodeNotHost, "only the host can pause a game")
+	}
+	if stateManager.game.Paused {
+		return nil, newError(ErrorCodeWrongState, "the game is already paused")
+	}
+	stateManager.game.Paused = true
+	stateManager.game.PauseReason = reason
+	pauseDrawingTimer(stateManager.game)
+	saveGame(ctx, stateManager.game)
+	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
+	if err != nil {
+		return nil, err
+	}
+	return gameStatus, nil
+}
+
+// ResumeGame lets the host continue a game they paused
+func ResumeGame(ctx context.Context, groupName string, playerName string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "ResumeGame", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	hostName := stateManager.game.GetHostName()
+	if hostName == nil || playerName != *hostName {
+		return nil, newError(ErrorCodeNotHost, "only the host can resume a game")
+	}
+	if !stateManager.game.Paused {
+		return nil, newError(ErrorCodeWrongState, "the game is not paused")
+	}
+	stateManager.game.Paused = false
+	stateManager.game.PauseReason = ""
+	resumeDrawingTimer(stateManager.game)
+	saveGame(ctx, stateManager.game)
 	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
 	if err != nil {
 		return nil, err
@@ -243,24 +509,218 @@ func StartGame(groupName string, playerName string) (*GameStatusResponse, error)
 	return gameStatus, nil
 }
 
+// Rematch starts a new game with the same players and settings once a game is over.
+// The finished game stays in the archive.
+func Rematch(ctx context.Context, groupName string, playerName string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "Rematch", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	finishedGame := stateManager.game
+	hostName := finishedGame.GetHostName()
+	if hostName == nil || playerName != *hostName {
+		return nil, newError(ErrorCodeNotHost, "only the host can start a rematch")
+	}
+	if finishedGame.Paused {
+		return nil, ErrGamePaused
+	}
+	if finishedGame.CurrentState != models.GameOver {
+		return nil, newError(ErrorCodeWrongState, "a rematch can only be started once the game is over")
+	}
+	game := restartedGame(finishedGame)
+	saveGame(ctx, game)
+	gameStatus, err := gameStatusForPlayer(game, playerName)
+	if err != nil {
+		return nil, err
+	}
+	return gameStatus, nil
+}
+
+// restartedGame is a new game waiting for players with the same group, settings and players as another, without any of its progress
+func restartedGame(previousGame *models.Game) *models.Game {
+	players := make([]*models.Player, len(previousGame.Players))
+	for i, player := range previousGame.Players {
+		players[i] = &models.Player{Name: player.Name, Host: player.Host, Language: player.Language, Bot: player.Bot}
+	}
+	return &models.Game{
+		ID:           models.NewGameID(),
+		StartedAt:    time.Now(),
+		GroupName:    previousGame.GroupName,
+		Fixture:      previousGame.Fixture,
+		Settings:     previousGame.Settings,
+		Players:      players,
+		CurrentState: models.WaitingForPlayers,
+	}
+}
+
+// GetGameHistory lists the games a group has archived, oldest first
+func GetGameHistory(ctx context.Context, groupName string) (_ []*archive.Summary, err error) {
+	ctx, operation := startOperation(ctx, "GetGameHistory", groupName, "")
+	defer operation.end(&err)
+	return archive.GetStore().ListTranscripts(groupName)
+}
+
+// GetGameTranscript gets the full archived history of one of a group's games
+func GetGameTranscript(ctx context.Context, groupName string, gameID string) (_ *archive.Transcript, err error) {
+	ctx, operation := startOperation(ctx, "GetGameTranscript", groupName, "")
+	defer operation.end(&err)
+	return archive.GetStore().LoadTranscript(groupName, gameID)
+}
+
+// GetDrawingImage gets the image of a drawing that was submitted
+func GetDrawingImage(ctx context.Context, imageID string) (_ *images.StoredDrawing, err error) {
+	ctx, operation := startOperation(ctx, "GetDrawingImage", "", "")
+	defer operation.end(&err)
+	return images.GetDrawingStore().LoadDrawing(imageID)
+}
+
+// SetPlayerLanguage changes the language a player gets server messages in, it can be changed even while the game is paused
+func SetPlayerLanguage(ctx context.Context, groupName string, playerName string, languageTag string) (_ *GameStatusResponse, err error) {
+	ctx, operation := startOperation(ctx, "SetPlayerLanguage", groupName, playerName)
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	player := stateManager.game.GetPlayer(playerName)
+	if player == nil {
+		return nil, ErrPlayerNotInGame
+	}
+	language, supported := localization.ParseLanguage(languageTag)
+	if !supported {
+		return nil, newError(ErrorCodeInvalidInput, fmt.Sprintf("%s is not a supported language", languageTag))
+	}
+	player.Language = string(language)
+	saveGame(ctx, stateManager.game)
+	gameStatus, err := gameStatusForPlayer(stateManager.game, playerName)
+	if err != nil {
+		return nil, err
+	}
+	return gameStatus, nil
+}
+
+// GetPlayerLanguage returns the language a player picked, if the player exists and picked one
+func GetPlayerLanguage(groupName string, playerName string) (localization.Language, bool) {
+	defer models.LockGroup(groupName)()
+	game := models.GetGameProvider().LoadGame(groupName)
+	if game == nil {
+		return "", false
+	}
+	player := game.GetPlayer(playerName)
+	if player == nil || player.Language == "" {
+		return "", false
+	}
+	return localization.Language(player.Language), true
+}
+
+// ErrNoTimelapse is returned for drawings that weren't submitted as strokes and so can't be replayed
+var ErrNoTimelapse = newError(ErrorCodeNoTimelapse, "This drawing wasn't submitted as strokes so it has no timelapse")
+
+// GetDrawingTimelapse gets an animated GIF of a drawing in the group's current round being drawn.
+// The one stored when the drawing was submitted is used unless other options are asked for, those are rendered
+// once and kept for a while.
+func GetDrawingTimelapse(ctx context.Context, groupName string, author string, options images.TimelapseOptions) (_ []byte, err error) {
+	ctx, operation := startOperation(ctx, "GetDrawingTimelapse", groupName, "")
+	defer operation.end(&err)
+	stateManager, err := getManagerForGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+	var drawing *models.Drawing
+	for _, currentDrawing := range stateManager.game.Drawings {
+		if currentDrawing.Author == author {
+			drawing = currentDrawing
+			break
+		}
+	}
+	if drawing == nil {
+		return nil, newError(ErrorCodeDrawingNotFound, fmt.Sprintf("%s has no drawing in this round", author))
+	}
+	if len(drawing.Strokes) == 0 {
+		return nil, ErrNoTimelapse
+	}
+	// Rendering takes a while, so it's done from a copy of the strokes without holding up everyone else in the group
+	timelapseID, strokes := drawing.TimelapseID, copyStrokes(drawing.Strokes)
+	operation.unlockGroup()
+	if timelapseID != "" && options == images.GetTimelapseOptions() {
+		storedTimelapse, err := images.GetDrawingStore().LoadDrawing(timelapseID)
+		if err == nil {
+			return storedTimelapse.Data, nil
+		}
+		logging.GetLogger().Error("Failed to load a drawing's timelapse", logging.Fields{"group": groupName, "player": author, "error": err})
+	}
+	return renderTimelapse(strokes, options)
+}
+
+// Timelapses rendered with other options than the stored ones are kept for an hour after they were last asked for
+var renderedTimelapses = cache.New(time.Hour, 10*time.Minute)
+
+// renderTimelapse renders a timelapse unless one of the same strokes with the same options was rendered recently
+func renderTimelapse(strokes []*models.Stroke, options images.TimelapseOptions) ([]byte, error) {
+	key, err := json.Marshal(struct {
+		Strokes []*models.Stroke
+		Options images.TimelapseOptions
+	}{strokes, options})
+	if err != nil {
+		return nil, err
+	}
+	renderKey := images.DrawingID(key)
+	if timelapse, found := renderedTimelapses.Get(renderKey); found {
+		renderedTimelapses.Set(renderKey, timelapse, cache.DefaultExpiration)
+		return timelapse.([]byte), nil
+	}
+	timelapse, err := images.RenderTimelapse(strokes, options)
+	if err != nil {
+		return nil, err
+	}
+	renderedTimelapses.Set(renderKey, timelapse, cache.DefaultExpiration)
+	return timelapse, nil
+}
+
+func copyStrokes(strokes []*models.Stroke) []*models.Stroke {
+	copies := make([]*models.Stroke, len(strokes))
+	for i, stroke := range strokes {
+		copies[i] = &models.Stroke{Color: stroke.Color, Width: stroke.Width, Points: append([]models.StrokePoint{}, stroke.Points...)}
+	}
+	return copies
+}
+
+func drawingImageURL(imageID string) string {
+	return "/api/images/" + imageID
+}
+
+func drawingThumbnailURL(drawing *models.Drawing) string {
+	// Drawings submitted before thumbnails existed only have their full image
+	if drawing.ThumbnailID == "" {
+		return drawingImageURL(drawing.ImageID)
+	}
+	return drawingImageURL(drawing.ThumbnailID)
+}
+
 func gameStatusForPlayer(game *models.Game, playerName string) (*GameStatusResponse, error) {
 	var currentPlayer *models.Player
 	players := make([]*Player, len(game.Players))
 	for i, player := range game.Players {
-		players[i] = &Player{Name: player.Name, Points: player.Points, Host: player.Host}
+		players[i] = &Player{Name: player.Name, Points: player.Points, Host: player.Host, Bot: player.Bot}
 		if player.Name == playerName {
 			currentPlayer = player
 		}
 	}
 	if currentPlayer == nil {
-		return nil, errors.New("cannot find current player in game")
+		return nil, ErrPlayerNotInGame
 	}
 	// Set base properties that do not depend on game state
 	gameStatusResponse := &GameStatusResponse{
-		GroupName:     game.GroupName,
-		CurrentPlayer: &CurrentPlayer{Name: currentPlayer.Name, IsHost: currentPlayer.Host},
-		CurrentState:  string(game.CurrentState),
-		Players:       players,
+		GroupName:       game.GroupName,
+		CurrentPlayer:   &CurrentPlayer{Name: currentPlayer.Name, IsHost: currentPlayer.Host, Language: currentPlayer.Language},
+		CurrentState:    string(game.CurrentState),
+		Players:         players,
+		Paused:          game.Paused,
+		PauseReason:     game.PauseReason,
+		DrawingDeadline: game.DrawingDeadline,
+		BotTurnAt:       nextBotTurn(game),
 	}
 	// Add any state-dependent properties to the status
 	currentState, err := getCurrentState(game)
@@ -274,10 +734,18 @@ func gameStatusForPlayer(game *models.Game, playerName string) (*GameStatusRespo
 	return gameStatusResponse, nil
 }
 
-func getManagerForGroup(groupName string) (*StateManager, error) {
+// getManagerForGroup loads a group's game and catches it up on the deadline and bot turns that are due.
+// Callers hold the group's lock from startOperation, so whatever is due only happens once.
+func getManagerForGroup(ctx context.Context, groupName string) (*StateManager, error) {
 	gameState := models.GetGameProvider().LoadGame(groupName)
 	if gameState == nil {
-		return nil, errors.New("Could not find a group with that name")
+		return nil, ErrGameNotFound
+	}
+	recordState(ctx, gameState)
+	changed := enforceDrawingDeadline(gameState)
+	changed = playBotTurns(gameState) || changed
+	if changed {
+		saveGame(ctx, gameState)
 	}
 	stateHandler, err := getCurrentState(gameState)
 	if err != nil {
@@ -288,6 +756,18 @@ func getManagerForGroup(groupName string) (*StateManager, error) {
 }
 
 func getCurrentState(game *models.Game) (state, error) {
+	activeState, err := getActiveState(game)
+	if err != nil {
+		return nil, err
+	}
+	// A paused game keeps its state but rejects any actions until it's resumed
+	if game.Paused {
+		return pausedState{game: game, activeState: activeState}, nil
+	}
+	return activeState, nil
+}
+
+func getActiveState(game *models.Game) (state, error) {
 	switch currentState := game.CurrentState; currentState {
 	case models.DecoyPromptCreation:
 		return decoyPromptCreatingState{game: game}, nil
@@ -301,6 +781,8 @@ func getCurrentState(game *models.Game) (state, error) {
 		return drawingsInProgressState{game: game}, nil
 	case models.Scoring:
 		return scoringState{game: game}, nil
+	case models.GameOver:
+		return gameOverState{game: game}, nil
 	default:
 		return nil, errors.New("Game is at an unknown state")
 	}
@@ -314,33 +796,3 @@ func isPlayerInGroup(playerName string, playersInGroup []*models.Player) bool {
 	}
 	return false
 }
-
-// DEBUG CODE - dont keep this forever.
-
-// SetGameState is a debug method for forcing the gamestate to make UI testing easier.
-func SetGameState(gameStateName string) (*GameStatusResponse, error) {
-	gameState := models.GameState(gameStateName)
-	var game *models.Game = nil
-	switch currentState := gameState; currentState {
-	case models.Scoring:
-		game = test.GameInScoringState()
-	case models.DecoyPromptCreation:
-		game = test.GameInDecoyPromptCreationState()
-	case models.Voting:
-		game = test.GameInVotingState()
-	case models.WaitingForPlayers:
-		game = test.GameInWaitingForPlayersState()
-	case models.InitialPromptCreation:
-		game = test.GameInInitialPromptCreationState()
-	case models.DrawingsInProgress:
-		game = test.GameInDrawingsInProgressState()
-	default:
-		return nil, fmt.Errorf("failed to set game to state %s", gameState)
-	}
-	models.GetGameProvider().SaveGame(game)
-	gameStatus, err := gameStatusForPlayer(game, *game.GetHostName())
-	if err != nil {
-		return nil, err
-	}
-	return gameStatus, nil
-}
@@ -1,39 +1,68 @@
 package statemanager
 
 import (
+	"bytes"
+	"context"
+	"drawydraw/archive"
+	"drawydraw/images"
+	"drawydraw/localization"
+	"drawydraw/logging"
 	"drawydraw/models"
 	"drawydraw/test"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/gif"
+	"image/png"
+	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// Game operations are logged, only errors are written while testing to keep the output readable
+func TestMain(m *testing.M) {
+	logging.SetLogger(logging.NewLogger(os.Stderr, logging.LevelError))
+	os.Exit(m.Run())
+}
+
 func TestCreateGroup_NewGroup_Succeeds(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	err := CreateGroup(groupName)
+	err := CreateGroup(context.Background(), groupName, models.GameSettings{})
 	assert.Nil(t, err)
 }
 
 func TestCreateGroup_GroupExists_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	CreateGroup(groupName)
-	err := CreateGroup(groupName)
+	CreateGroup(context.Background(), groupName, models.GameSettings{})
+	err := CreateGroup(context.Background(), groupName, models.GameSettings{})
 	assert.NotNil(t, err)
 }
 
 func TestCreateGroup_ShortGroupName_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
-	err := CreateGroup("")
+	err := CreateGroup(context.Background(), "", models.GameSettings{})
 	assert.NotNil(t, err)
 }
 
+func TestErrorCodeOf(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	_, err := AddPlayer(context.Background(), "player", "missing group", false)
+	assert.Equal(t, ErrorCodeGameNotFound, ErrorCodeOf(err))
+	assert.Equal(t, ErrorCodeTranscriptNotFound, ErrorCodeOf(archive.ErrTranscriptNotFound))
+	assert.Equal(t, ErrorCodeInvalidInput, ErrorCodeOf(images.ErrCorruptImage))
+	assert.Equal(t, ErrorCodeInternal, ErrorCodeOf(errors.New("disk is full")))
+}
+
 func TestAddPlayer_AddHost_Succeeds(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	CreateGroup(groupName)
-	gameStatus, err := AddPlayer("mama cat", groupName, true)
+	CreateGroup(context.Background(), groupName, models.GameSettings{})
+	gameStatus, err := AddPlayer(context.Background(), "mama cat", groupName, true)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	expectedPlayers := []*Player{{Name: "mama cat", Host: true}}
@@ -45,31 +74,31 @@ func TestAddPlayer_AddHost_Succeeds(t *testing.T) {
 func TestAddPlayer_AddToHostedGame_Succeeds(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	CreateGroup(groupName)
-	AddPlayer("papa cat", groupName, true)
-	gameState, _ := AddPlayer("mama cat", groupName, false)
+	CreateGroup(context.Background(), groupName, models.GameSettings{})
+	AddPlayer(context.Background(), "papa cat", groupName, true)
+	gameState, _ := AddPlayer(context.Background(), "mama cat", groupName, false)
 	assert.NotNil(t, gameState)
 }
 
 func TestAddPlayer_AddToUnHostedGame_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	CreateGroup(groupName)
-	gameState, _ := AddPlayer("mama cat", groupName, false)
+	CreateGroup(context.Background(), groupName, models.GameSettings{})
+	gameState, _ := AddPlayer(context.Background(), "mama cat", groupName, false)
 	assert.Nil(t, gameState)
 }
 
 func TestAddPlayer_NoGroupCreated_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	_, err := AddPlayer("baby cat", groupName, false)
+	_, err := AddPlayer(context.Background(), "baby cat", groupName, false)
 	assert.NotNil(t, err)
 }
 
 func TestAddPlayer_ShortPlayerName_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
-	_, err := AddPlayer("", groupName, false)
+	_, err := AddPlayer(context.Background(), "", groupName, false)
 	assert.NotNil(t, err)
 }
 
@@ -77,9 +106,9 @@ func TestAddPlayer_PlayerExistsInGroup_NoOps(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	groupName := "group"
 	playerName := "baby cat"
-	CreateGroup(groupName)
-	AddPlayer(playerName, groupName, true)
-	gameStatus, err := AddPlayer(playerName, groupName, true)
+	CreateGroup(context.Background(), groupName, models.GameSettings{})
+	AddPlayer(context.Background(), playerName, groupName, true)
+	gameStatus, err := AddPlayer(context.Background(), playerName, groupName, true)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	expectedPlayers := []*Player{{Name: "baby cat", Host: true}}
@@ -90,48 +119,178 @@ func TestAddPlayer_AddSecondHost_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInWaitingForPlayersState()
 	models.GetGameProvider().SaveGame(game)
-	gameStatus, err := AddPlayer("extra cat", game.GroupName, true)
+	gameStatus, err := AddPlayer(context.Background(), "extra cat", game.GroupName, true)
 	assert.NotNil(t, err)
 	assert.Nil(t, gameStatus)
 }
 
+func TestWatchGame_SignalsChanges(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	changes, stop := WatchGame(game.GroupName)
+	defer stop()
+	otherChanges, stopOther := WatchGame("other group")
+	defer stopOther()
+	AddPlayer(context.Background(), "kitten", game.GroupName, false)
+	AddPlayer(context.Background(), "other kitten", game.GroupName, false)
+	assert.Len(t, changes, 1)
+	assert.Len(t, otherChanges, 0)
+}
+
+func TestWatchGame_Stopped_NotSignaled(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	changes, stop := WatchGame(game.GroupName)
+	stop()
+	AddPlayer(context.Background(), "kitten", game.GroupName, false)
+	assert.Len(t, changes, 0)
+}
+
 func TestStartGame_Host_Succeeds(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInWaitingForPlayersState()
 	models.GetGameProvider().SaveGame(game)
-	gameStatus, err := StartGame(game.GroupName, game.Players[0].Name)
+	gameStatus, err := StartGame(context.Background(), game.GroupName, game.Players[0].Name)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, gameStatus.CurrentState, models.InitialPromptCreation)
 }
 
+func TestStartGame_TracksPhase(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := StartGame(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	savedGame := models.GetGameProvider().LoadGame(game.GroupName)
+	assert.Equal(t, models.InitialPromptCreation, savedGame.Phase)
+	assert.WithinDuration(t, time.Now(), savedGame.PhaseStartedAt, time.Minute)
+}
+
+func TestStartGame_LogsOperationAndTransition(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	logOutput := test.SetupTestLogger(t, logging.LevelInfo)
+	game := test.GameInWaitingForPlayersState()
+	trackPhase(context.Background(), game)
+	models.GetGameProvider().SaveGame(game)
+	ctx := logging.WithRequestID(context.Background(), "request-1")
+	_, err := StartGame(ctx, game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	var transition, operation map[string]interface{}
+	decoder := json.NewDecoder(logOutput)
+	assert.Nil(t, decoder.Decode(&transition))
+	assert.Nil(t, decoder.Decode(&operation))
+	assert.Equal(t, "Game state changed", transition["message"])
+	assert.Equal(t, "request-1", transition["requestId"])
+	assert.Equal(t, game.GroupName, transition["group"])
+	assert.Equal(t, string(models.WaitingForPlayers), transition["from"])
+	assert.Equal(t, string(models.InitialPromptCreation), transition["state"])
+	assert.Equal(t, "Game operation", operation["message"])
+	assert.Equal(t, "info", operation["level"])
+	assert.Equal(t, "request-1", operation["requestId"])
+	assert.Equal(t, "StartGame", operation["operation"])
+	assert.Equal(t, game.GroupName, operation["group"])
+	assert.Equal(t, game.Players[0].Name, operation["player"])
+	assert.Equal(t, string(models.InitialPromptCreation), operation["state"])
+}
+
+// Counts how many times games are loaded
+type loadCountingProvider struct {
+	models.GameProvider
+	loads int
+}
+
+func (provider *loadCountingProvider) LoadGame(groupName string) *models.Game {
+	provider.loads++
+	return provider.GameProvider.LoadGame(groupName)
+}
+
+func TestStartGame_LogsStateWithoutLoadingGameAgain(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	logOutput := test.SetupTestLogger(t, logging.LevelInfo)
+	provider := &loadCountingProvider{GameProvider: models.GetGameProvider()}
+	models.SetGameProvider(provider)
+	game := test.GameInWaitingForPlayersState()
+	provider.SaveGame(game)
+	_, err := StartGame(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, provider.loads)
+	assert.Contains(t, logOutput.String(), `"state":"InitialPromptCreation"`)
+}
+
+func TestStartGame_NonHost_LogsWarning(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	logOutput := test.SetupTestLogger(t, logging.LevelWarn)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := StartGame(context.Background(), game.GroupName, game.Players[1].Name)
+	assert.NotNil(t, err)
+	var operation map[string]interface{}
+	assert.Nil(t, json.Unmarshal(logOutput.Bytes(), &operation))
+	assert.Equal(t, "warn", operation["level"])
+	assert.Equal(t, string(ErrorCodeNotHost), operation["code"])
+	assert.Equal(t, err.Error(), operation["error"])
+}
+
 func TestStartGame_NonHost_Fails(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInWaitingForPlayersState()
 	models.GetGameProvider().SaveGame(game)
-	gameStatus, err := StartGame(game.GroupName, game.Players[1].Name)
+	gameStatus, err := StartGame(context.Background(), game.GroupName, game.Players[1].Name)
 	assert.NotNil(t, err)
 	assert.Nil(t, gameStatus)
 }
 
+func TestStartGame_NoHost_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	assert.Nil(t, CreateGroup(context.Background(), "hostless", models.GameSettings{}))
+	gameStatus, err := StartGame(context.Background(), "hostless", "mama cat")
+	assert.Equal(t, ErrorCodeNotHost, ErrorCodeOf(err))
+	assert.Nil(t, gameStatus)
+}
+
 func TestAddPrompt_Succeeds(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInInitialPromptCreationState()
 	models.GetGameProvider().SaveGame(game)
 	// The game should only transition to the drawing state when all players submit their prompts
 	for _, player := range game.Players[:2] {
-		gameState, err := AddPrompt(player.Name, game.GroupName, "tuna", "stinky", "yummy")
+		gameState, err := AddPrompt(context.Background(), player.Name, game.GroupName, "tuna", "stinky", "yummy")
 		assert.Nil(t, err)
 		assert.NotNil(t, gameState)
 		assert.EqualValues(t, gameState.CurrentState, models.InitialPromptCreation)
 	}
 	// The game should only transition to the drawing state when all players submit their prompts
-	gameState, err := AddPrompt(game.Players[2].Name, game.GroupName, "sardine", "small", "funny")
+	gameState, err := AddPrompt(context.Background(), game.Players[2].Name, game.GroupName, "sardine", "small", "funny")
 	assert.Nil(t, err)
 	assert.NotNil(t, gameState)
 	assert.EqualValues(t, gameState.CurrentState, models.DrawingsInProgress)
 }
 
+func TestAddPrompt_PlayerNotInGame_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := AddPrompt(context.Background(), "stray cat", game.GroupName, "tuna", "stinky", "yummy")
+	assert.Equal(t, ErrPlayerNotInGame, err)
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, game.OriginalPrompts)
+}
+
+func TestAddPrompt_DecoyForOwnDrawing_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInDecoyPromptCreationState()
+	models.GetGameProvider().SaveGame(game)
+	activeDrawing := game.GetActiveDrawing()
+	decoyCount := len(activeDrawing.DecoyPrompts)
+	gameStatus, err := AddPrompt(context.Background(), activeDrawing.Author, game.GroupName, "tuna", "stinky", "yummy")
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+	assert.Nil(t, gameStatus)
+	assert.Len(t, activeDrawing.DecoyPrompts, decoyCount)
+}
+
 func TestGameStatusForPlayer_Fails_PlayerMissing(t *testing.T) {
 	game := test.GameInInitialPromptCreationState()
 	gameStatus, err := gameStatusForPlayer(game, "missing cat")
@@ -141,26 +300,472 @@ func TestGameStatusForPlayer_Fails_PlayerMissing(t *testing.T) {
 
 func TestSubmitDrawing(t *testing.T) {
 	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
 	game := test.GameInDrawingsInProgressState()
 	models.GetGameProvider().SaveGame(game)
 	// The game should only transition to the decoy prompt phase when all players submit their drawings
 	for _, player := range game.Players[:2] {
-		gameState, err := SubmitDrawing(player.Name, game.GroupName, "mock data")
+		gameState, err := SubmitDrawing(context.Background(), player.Name, game.GroupName, test.MockImageData)
 		assert.Nil(t, err)
 		assert.NotNil(t, gameState)
 		assert.EqualValues(t, gameState.CurrentState, models.DrawingsInProgress)
 	}
-	gameStatus, err := SubmitDrawing(game.Players[2].Name, game.GroupName, "mock data")
+	gameStatus, err := SubmitDrawing(context.Background(), game.Players[2].Name, game.GroupName, test.MockImageData)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, gameStatus.CurrentState, models.DecoyPromptCreation)
 }
 
+func TestSubmitDrawing_StoresImage(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	imageID := game.Drawings[0].ImageID
+	storedDrawing, err := GetDrawingImage(context.Background(), imageID)
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", storedDrawing.MediaType)
+	assert.Equal(t, images.DrawingID(storedDrawing.Data), imageID)
+	// The same image is stored only once
+	_, err = SubmitDrawing(context.Background(), game.Players[1].Name, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	assert.Equal(t, imageID, game.Drawings[1].ImageID)
+}
+
+func TestSubmitDrawing_StoresThumbnail(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	largeImage := image.NewRGBA(image.Rect(0, 0, 700, 700))
+	buffer := bytes.Buffer{}
+	png.Encode(&buffer, largeImage)
+	_, err := SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, images.EncodeDataURL("image/png", buffer.Bytes()))
+	assert.Nil(t, err)
+	thumbnail, err := GetDrawingImage(context.Background(), game.Drawings[0].ThumbnailID)
+	assert.Nil(t, err)
+	thumbnailConfig, err := png.DecodeConfig(bytes.NewReader(thumbnail.Data))
+	assert.Nil(t, err)
+	assert.Equal(t, images.ThumbnailSize, thumbnailConfig.Width)
+	assert.Equal(t, images.ThumbnailSize, thumbnailConfig.Height)
+}
+
+func TestGetGameState_Scoring_PastDrawingsUseThumbnails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInScoringState()
+	game.Drawings[1].Scored = true
+	game.Drawings[1].ThumbnailID = "thumbnail"
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.PastDrawings, 1)
+	assert.Equal(t, "/api/images/thumbnail", gameStatus.PastDrawings[0].ThumbnailURL)
+	assert.Equal(t, "/api/images/"+game.Drawings[1].ImageID, gameStatus.PastDrawings[0].ImageURL)
+	// Drawings without a thumbnail fall back to the full image
+	assert.Equal(t, gameStatus.CurrentDrawing.ImageURL, gameStatus.CurrentDrawing.ThumbnailURL)
+}
+
+func TestSubmitDrawing_InvalidImageData_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, "mock data")
+	assert.Equal(t, images.ErrInvalidDataURL, err)
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, game.Drawings)
+}
+
+func TestSubmitDrawing_UnsupportedImage_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, "data:image/bmp;base64,Qk0eAAAAAAAAABoAAAAMAAAAAQABAAEAGAAAAP8A")
+	assert.Equal(t, images.ErrUnsupportedImageFormat, err)
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, game.Drawings)
+}
+
+type countingDrawingStore struct {
+	images.DrawingStore
+	saves int
+}
+
+func (store *countingDrawingStore) SaveDrawing(drawing *images.StoredDrawing) (string, error) {
+	store.saves++
+	return store.DrawingStore.SaveDrawing(drawing)
+}
+
+func setupCountingDrawingStore(t *testing.T) *countingDrawingStore {
+	test.SetupTestDrawingStore(t)
+	store := &countingDrawingStore{DrawingStore: images.GetDrawingStore()}
+	images.SetDrawingStore(store)
+	return store
+}
+
+func TestSubmitDrawing_Rejected_StoresNothing(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	store := setupCountingDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := SubmitDrawing(context.Background(), "stray cat", game.GroupName, test.MockImageData)
+	assert.Equal(t, ErrPlayerNotInGame, err)
+	assert.Zero(t, store.saves)
+	// The image and its thumbnail are stored once the drawing is accepted
+	_, err = SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, store.saves)
+	_, err = SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.Equal(t, ErrDrawingAlreadySubmitted, err)
+	assert.Equal(t, 2, store.saves)
+	votingGame := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(votingGame)
+	_, err = SubmitDrawing(context.Background(), votingGame.Players[0].Name, votingGame.GroupName, test.MockImageData)
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+	assert.Equal(t, 2, store.saves)
+}
+
+func TestSubmitStrokeDrawing(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	strokes := []*Stroke{
+		{Color: "#000000", Width: 5, Points: []StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 20, Y: 30, Time: 50}}},
+	}
+	gameStatus, err := SubmitStrokeDrawing(context.Background(), game.Players[0].Name, game.GroupName, strokes)
+	assert.Nil(t, err)
+	assert.True(t, gameStatus.CurrentPlayer.HasCompletedAction)
+	expectedStrokes := []*models.Stroke{
+		{Color: "#000000", Width: 5, Points: []models.StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 20, Y: 30, Time: 50}}},
+	}
+	assert.EqualValues(t, expectedStrokes, game.Drawings[0].Strokes)
+	// The strokes are rasterized for clients that need an image
+	storedDrawing, err := GetDrawingImage(context.Background(), game.Drawings[0].ImageID)
+	assert.Nil(t, err)
+	imageConfig, err := png.DecodeConfig(bytes.NewReader(storedDrawing.Data))
+	assert.Nil(t, err)
+	assert.Equal(t, images.StrokeCanvasSize, imageConfig.Width)
+}
+
+func TestGetDrawingTimelapse(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInScoringState()
+	game.Drawings[0].Strokes = []*models.Stroke{
+		{Color: "#000000", Width: 5, Points: []models.StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 20, Y: 30, Time: 500}}},
+	}
+	models.GetGameProvider().SaveGame(game)
+	timelapse, err := GetDrawingTimelapse(context.Background(), game.GroupName, game.Drawings[0].Author, images.DefaultTimelapseOptions)
+	assert.Nil(t, err)
+	animation, err := gif.DecodeAll(bytes.NewReader(timelapse))
+	assert.Nil(t, err)
+	assert.Len(t, animation.Image, 5)
+	_, err = GetDrawingTimelapse(context.Background(), game.GroupName, game.Drawings[1].Author, images.DefaultTimelapseOptions)
+	assert.Equal(t, ErrNoTimelapse, err)
+	_, err = GetDrawingTimelapse(context.Background(), game.GroupName, "nobody", images.DefaultTimelapseOptions)
+	assert.NotNil(t, err)
+}
+
+func TestGetDrawingTimelapse_StoredOnSubmit(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	strokes := []*Stroke{
+		{Color: "#000000", Width: 5, Points: []StrokePoint{{X: 10, Y: 10, Time: 0}, {X: 20, Y: 30, Time: 500}}},
+	}
+	_, err := SubmitStrokeDrawing(context.Background(), game.Players[0].Name, game.GroupName, strokes)
+	assert.Nil(t, err)
+	storedTimelapse, err := images.GetDrawingStore().LoadDrawing(game.Drawings[0].TimelapseID)
+	assert.Nil(t, err)
+	assert.Equal(t, "image/gif", storedTimelapse.MediaType)
+	// The stored timelapse is served instead of rendering the strokes again
+	game.Drawings[0].Strokes[0].Points[1].Time = 1000
+	timelapse, err := GetDrawingTimelapse(context.Background(), game.GroupName, game.Players[0].Name, images.GetTimelapseOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, storedTimelapse.Data, timelapse)
+	// Other options still get rendered, once
+	renderedCount := renderedTimelapses.ItemCount()
+	options := images.TimelapseOptions{FrameRate: 2, MaxLength: time.Minute}
+	timelapse, err = GetDrawingTimelapse(context.Background(), game.GroupName, game.Players[0].Name, options)
+	assert.Nil(t, err)
+	animation, err := gif.DecodeAll(bytes.NewReader(timelapse))
+	assert.Nil(t, err)
+	assert.Len(t, animation.Image, 2)
+	assert.Equal(t, renderedCount+1, renderedTimelapses.ItemCount())
+	renderedAgain, err := GetDrawingTimelapse(context.Background(), game.GroupName, game.Players[0].Name, options)
+	assert.Nil(t, err)
+	assert.Equal(t, timelapse, renderedAgain)
+	assert.Equal(t, renderedCount+1, renderedTimelapses.ItemCount())
+}
+
+func TestSaveDraftDrawing(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	strokes := []*Stroke{{Color: "#000000", Width: 5, Points: []StrokePoint{{X: 10, Y: 10, Time: 0}}}}
+	gameStatus, err := SaveDraftStrokeDrawing(context.Background(), game.Players[0].Name, game.GroupName, strokes)
+	assert.Nil(t, err)
+	assert.False(t, gameStatus.CurrentPlayer.HasCompletedAction)
+	assert.Empty(t, game.Drawings)
+	// The draft comes back with the player's status, but not with anyone else's
+	gameStatus, err = GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, strokes, gameStatus.CurrentPlayer.DraftDrawing.Strokes)
+	assert.Equal(t, drawingImageURL(game.DraftDrawings[game.Players[0].Name].ImageID), gameStatus.CurrentPlayer.DraftDrawing.ImageURL)
+	gameStatus, err = GetGameState(context.Background(), game.GroupName, game.Players[1].Name)
+	assert.Nil(t, err)
+	assert.Nil(t, gameStatus.CurrentPlayer.DraftDrawing)
+	// Submitting the drawing discards the draft
+	gameStatus, err = SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	assert.Nil(t, gameStatus.CurrentPlayer.DraftDrawing)
+	assert.Empty(t, game.DraftDrawings)
+	_, err = SaveDraftDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.NotNil(t, err)
+}
+
+func TestSaveDraftDrawing_NotDrawing_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	store := setupCountingDrawingStore(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := SaveDraftDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.NotNil(t, err)
+	assert.Empty(t, game.DraftDrawings)
+	assert.Zero(t, store.saves)
+}
+
+func TestSaveDraftDrawing_PlayerMissing_StoresNothing(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	store := setupCountingDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := SaveDraftDrawing(context.Background(), "stray cat", game.GroupName, test.MockImageData)
+	assert.Equal(t, ErrPlayerNotInGame, err)
+	assert.Zero(t, store.saves)
+}
+
+func TestSaveDraftDrawing_ReplacesPreviousDraftImages(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	playerName := game.Players[0].Name
+	strokes := []*Stroke{{Color: "#000000", Width: 5, Points: []StrokePoint{{X: 10, Y: 10, Time: 0}}}}
+	_, err := SaveDraftStrokeDrawing(context.Background(), playerName, game.GroupName, strokes)
+	assert.Nil(t, err)
+	firstDraft := game.DraftDrawings[playerName]
+	// Saving the same draft again keeps its images
+	_, err = SaveDraftStrokeDrawing(context.Background(), playerName, game.GroupName, strokes)
+	assert.Nil(t, err)
+	_, err = images.GetDrawingStore().LoadDrawing(firstDraft.ImageID)
+	assert.Nil(t, err)
+	strokes[0].Points = append(strokes[0].Points, StrokePoint{X: 200, Y: 200, Time: 100})
+	_, err = SaveDraftStrokeDrawing(context.Background(), playerName, game.GroupName, strokes)
+	assert.Nil(t, err)
+	secondDraft := game.DraftDrawings[playerName]
+	for _, imageID := range []string{firstDraft.ImageID, firstDraft.ThumbnailID} {
+		_, err = images.GetDrawingStore().LoadDrawing(imageID)
+		assert.Equal(t, images.ErrDrawingNotFound, err)
+	}
+	_, err = images.GetDrawingStore().LoadDrawing(secondDraft.ImageID)
+	assert.Nil(t, err)
+	// Submitting something else deletes the last draft's images too
+	_, err = SubmitDrawing(context.Background(), playerName, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	_, err = images.GetDrawingStore().LoadDrawing(secondDraft.ImageID)
+	assert.Equal(t, images.ErrDrawingNotFound, err)
+	_, err = images.GetDrawingStore().LoadDrawing(game.Drawings[0].ImageID)
+	assert.Nil(t, err)
+}
+
+func TestAddPrompt_LastPrompt_StartsDrawingTimer(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	game.Settings.DrawingTimeLimit = time.Minute
+	models.GetGameProvider().SaveGame(game)
+	for _, player := range game.Players {
+		_, err := AddPrompt(context.Background(), player.Name, game.GroupName, "tuna", "stinky", player.Name)
+		assert.Nil(t, err)
+	}
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, gameStatus.CurrentState)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *gameStatus.DrawingDeadline, 5*time.Second)
+}
+
+func TestDrawingDeadline_SubmitsDrafts(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	deadline := time.Now().Add(time.Minute)
+	game.DrawingDeadline = &deadline
+	models.GetGameProvider().SaveGame(game)
+	_, err := SubmitDrawing(context.Background(), game.Players[0].Name, game.GroupName, test.MockImageData)
+	assert.Nil(t, err)
+	strokes := []*Stroke{{Color: "#000000", Width: 5, Points: []StrokePoint{{X: 10, Y: 10, Time: 0}}}}
+	_, err = SaveDraftStrokeDrawing(context.Background(), game.Players[1].Name, game.GroupName, strokes)
+	assert.Nil(t, err)
+	draft := game.DraftDrawings[game.Players[1].Name]
+	// Nothing is submitted until the deadline passes
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, gameStatus.CurrentState)
+	expiredDeadline := time.Now().Add(-time.Second)
+	game.DrawingDeadline = &expiredDeadline
+	gameStatus, err = GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DecoyPromptCreation, gameStatus.CurrentState)
+	assert.Nil(t, gameStatus.DrawingDeadline)
+	assert.Len(t, game.Drawings, 3)
+	assert.Equal(t, draft, game.Drawings[1])
+	assert.Equal(t, game.Players[1].AssignedPrompt, game.Drawings[1].OriginalPrompt)
+	// Players who never saved a draft get a blank canvas
+	blankImage, _ := images.RasterizeStrokes(nil)
+	assert.Equal(t, game.Players[2].Name, game.Drawings[2].Author)
+	assert.Equal(t, images.DrawingID(blankImage), game.Drawings[2].ImageID)
+}
+
+type failingDrawingStore struct {
+	images.DrawingStore
+}
+
+func (store *failingDrawingStore) SaveDrawing(drawing *images.StoredDrawing) (string, error) {
+	return "", errors.New("disk full")
+}
+
+func TestDrawingDeadline_BlankDrawingFails_TriesAgain(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	expiredDeadline := time.Now().Add(-time.Second)
+	game.DrawingDeadline = &expiredDeadline
+	models.GetGameProvider().SaveGame(game)
+	workingStore := images.GetDrawingStore()
+	images.SetDrawingStore(&failingDrawingStore{DrawingStore: workingStore})
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, gameStatus.CurrentState)
+	assert.Equal(t, &expiredDeadline, game.DrawingDeadline)
+	// The deadline is still there, so the drawings are submitted once they can be stored
+	images.SetDrawingStore(workingStore)
+	gameStatus, err = GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DecoyPromptCreation, gameStatus.CurrentState)
+	assert.Len(t, game.Drawings, len(game.Players))
+}
+
+func TestAdvanceGame_BlankDrawingFails_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	images.SetDrawingStore(&failingDrawingStore{DrawingStore: images.GetDrawingStore()})
+	_, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.NotNil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, game.CurrentState)
+}
+
+func TestDrawingDeadline_ConcurrentReads_SubmitOnce(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	expiredDeadline := time.Now().Add(-time.Second)
+	game.DrawingDeadline = &expiredDeadline
+	models.GetGameProvider().SaveGame(game)
+	var wait sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wait.Add(1)
+		go func(player *models.Player) {
+			defer wait.Done()
+			GetGameState(context.Background(), game.GroupName, player.Name)
+		}(game.Players[i%len(game.Players)])
+	}
+	wait.Wait()
+	game = models.GetGameProvider().LoadGame(game.GroupName)
+	assert.EqualValues(t, models.DecoyPromptCreation, game.CurrentState)
+	assert.Len(t, game.Drawings, len(game.Players))
+	for i, player := range game.Players {
+		assert.Equal(t, player.Name, game.Drawings[i].Author)
+	}
+}
+
+func TestSetPlayerLanguage(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	game.Paused = true
+	models.GetGameProvider().SaveGame(game)
+	_, found := GetPlayerLanguage(game.GroupName, game.Players[1].Name)
+	assert.False(t, found)
+	// Players can pick their language even while the game is paused
+	gameStatus, err := SetPlayerLanguage(context.Background(), game.GroupName, game.Players[1].Name, "es-AR")
+	assert.Nil(t, err)
+	assert.Equal(t, "es", gameStatus.CurrentPlayer.Language)
+	language, found := GetPlayerLanguage(game.GroupName, game.Players[1].Name)
+	assert.True(t, found)
+	assert.Equal(t, localization.Spanish, language)
+	_, err = SetPlayerLanguage(context.Background(), game.GroupName, game.Players[1].Name, "fr")
+	assert.Equal(t, ErrorCodeInvalidInput, ErrorCodeOf(err))
+	_, err = SetPlayerLanguage(context.Background(), game.GroupName, "stray cat", "es")
+	assert.Equal(t, ErrPlayerNotInGame, err)
+}
+
+func TestPauseGame_FreezesDrawingDeadline(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInDrawingsInProgressState()
+	deadline := time.Now().Add(time.Minute)
+	game.DrawingDeadline = &deadline
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := PauseGame(context.Background(), game.GroupName, *game.GetHostName(), "dinner time")
+	assert.Nil(t, err)
+	assert.Nil(t, gameStatus.DrawingDeadline)
+	assert.True(t, game.DrawingTimeLeft > 55*time.Second)
+	// Whatever time was left when the game was paused is given back on resume
+	game.DrawingTimeLeft = 30 * time.Second
+	gameStatus, err = ResumeGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, gameStatus.CurrentState)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), *gameStatus.DrawingDeadline, 5*time.Second)
+}
+
+func TestSubmitStrokeDrawing_InvalidStrokes_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	strokes := []*Stroke{{Color: "blue", Width: 5, Points: []StrokePoint{{X: 10, Y: 10}}}}
+	gameStatus, err := SubmitStrokeDrawing(context.Background(), game.Players[0].Name, game.GroupName, strokes)
+	assert.Equal(t, images.ErrInvalidStroke, err)
+	assert.Nil(t, gameStatus)
+	gameStatus, err = SubmitStrokeDrawing(context.Background(), game.Players[0].Name, game.GroupName, []*Stroke{nil})
+	assert.Equal(t, images.ErrInvalidStroke, err)
+	assert.Empty(t, game.Drawings)
+}
+
+func TestGetGameState_Scoring_IncludesStrokes(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInScoringState()
+	game.Drawings[0].Strokes = []*models.Stroke{
+		{Color: "#123456", Width: 3, Points: []models.StrokePoint{{X: 1, Y: 2, Time: 3}}},
+	}
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[1].Name)
+	assert.Nil(t, err)
+	expectedStrokes := []*Stroke{{Color: "#123456", Width: 3, Points: []StrokePoint{{X: 1, Y: 2, Time: 3}}}}
+	assert.EqualValues(t, expectedStrokes, gameStatus.CurrentDrawing.Strokes)
+}
+
 func TestSubmitDrawing_Fails_PlayerMissing(t *testing.T) {
 	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
 	game := test.GameInDrawingsInProgressState()
 	models.GetGameProvider().SaveGame(game)
-	gameStatus, err := SubmitDrawing("Missing player", game.GroupName, "mock data")
+	gameStatus, err := SubmitDrawing(context.Background(), "Missing player", game.GroupName, test.MockImageData)
 	assert.NotNil(t, err)
 	assert.Nil(t, gameStatus)
 }
@@ -170,12 +775,12 @@ func TestAddDecoyPrompt_Success(t *testing.T) {
 	game := test.GameInDecoyPromptCreationState()
 	models.GetGameProvider().SaveGame(game)
 	// The game should only move to voting once all players submit decoy prompts
-	gameStatus, err := AddPrompt(game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
+	gameStatus, err := AddPrompt(context.Background(), game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, gameStatus.CurrentState, models.DecoyPromptCreation)
 
-	gameStatus, err = AddPrompt(game.Players[2].Name, game.GroupName, "salmon", "strange", "big")
+	gameStatus, err = AddPrompt(context.Background(), game.Players[2].Name, game.GroupName, "salmon", "strange", "big")
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, gameStatus.CurrentState, models.Voting)
@@ -184,15 +789,16 @@ func TestAddDecoyPrompt_Success(t *testing.T) {
 func TestCastVote_Success(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInVotingState()
+	game.Settings.AllowSelfVotes = true
 	models.GetGameProvider().SaveGame(game)
 	activeDrawing := game.Drawings[0]
 	// Player 0 voted for their own decoy prompt
-	gameStatus, err := CastVote(game.Players[0].Name, game.GroupName, activeDrawing.DecoyPrompts[game.Players[0].Name].Identifier)
+	gameStatus, err := CastVote(context.Background(), game.Players[0].Name, game.GroupName, activeDrawing.DecoyPrompts[game.Players[0].Name].Identifier)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, models.Voting, gameStatus.CurrentState)
 	// Player 2 voted for the correct prompt
-	gameStatus, err = CastVote(game.Players[2].Name, game.GroupName, activeDrawing.OriginalPrompt.Identifier)
+	gameStatus, err = CastVote(context.Background(), game.Players[2].Name, game.GroupName, activeDrawing.OriginalPrompt.Identifier)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	// Once all players vote we should move to scoring
@@ -202,12 +808,75 @@ func TestCastVote_Success(t *testing.T) {
 
 }
 
+func TestCastVote_OwnDecoy_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	activeDrawing := game.Drawings[0]
+	gameStatus, err := CastVote(context.Background(), game.Players[0].Name, game.GroupName, activeDrawing.DecoyPrompts[game.Players[0].Name].Identifier)
+	assert.Equal(t, ErrVotedForOwnDecoy, err)
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, activeDrawing.Votes)
+}
+
+func TestCastVote_OwnDrawing_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	activeDrawing := game.Drawings[0]
+	gameStatus, err := CastVote(context.Background(), activeDrawing.Author, game.GroupName, activeDrawing.OriginalPrompt.Identifier)
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, activeDrawing.Votes)
+}
+
+func TestGetGameState_Voting_HidesOwnDecoy(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	ownDecoy := game.Drawings[0].DecoyPrompts[game.Players[0].Name]
+	gameStatus, err := GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.CurrentDrawing.Prompts, 2)
+	for _, prompt := range gameStatus.CurrentDrawing.Prompts {
+		assert.NotEqual(t, ownDecoy.Identifier, prompt.Identifier)
+	}
+	// Players can see their own decoy when the game allows voting for it
+	game.Settings.AllowSelfVotes = true
+	gameStatus, err = GetGameState(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.CurrentDrawing.Prompts, 3)
+}
+
+func TestCalculateStandings_SelfVote_AwardsNoPoints(t *testing.T) {
+	game := test.GameInScoringState()
+	game.Settings.AllowSelfVotes = true
+	activeDrawing := game.GetActiveDrawing()
+	// Player 3 voted for their own decoy, nobody should earn points for it
+	standings := scoringState{game: game}.calculateStandings(activeDrawing, game)
+	assert.EqualValues(t, 0, (*standings)["player3"].TotalScore)
+	assert.Empty(t, (*standings)["player3"].RoundPointsBreakdown)
+	assert.EqualValues(t, 3, (*standings)["player1"].TotalScore)
+	assert.EqualValues(t, 1, (*standings)["player2"].TotalScore)
+}
+
+func TestCalculateStandings_FooledPlayer(t *testing.T) {
+	game := test.GameInScoringState()
+	activeDrawing := game.GetActiveDrawing()
+	activeDrawing.Votes["player3"].SelectedPrompt = activeDrawing.DecoyPrompts["player1"]
+	standings := scoringState{game: game}.calculateStandings(activeDrawing, game)
+	// Player 1 chose the right prompt and fooled player 3
+	assert.EqualValues(t, 4, (*standings)["player1"].TotalScore)
+	assert.Contains(t, (*standings)["player1"].RoundPointsBreakdown, &PointsBreakdown{Amount: 1, Reason: FooledPlayer, CausingPlayer: "player3"})
+	assert.EqualValues(t, 0, (*standings)["player3"].TotalScore)
+}
+
 func TestAddDecoyPrompt_Error_duplicatePromptEntry(t *testing.T) {
 	test.SetupTestGameProvider(t)
 	game := test.GameInDecoyPromptCreationState()
 	models.GetGameProvider().SaveGame(game)
-	AddPrompt(game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
-	gameStatus, err := AddPrompt(game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
+	AddPrompt(context.Background(), game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
+	gameStatus, err := AddPrompt(context.Background(), game.Players[0].Name, game.GroupName, "fish", "tasty", "red")
 	assert.Nil(t, gameStatus)
 	assert.NotNil(t, err)
 }
@@ -217,7 +886,7 @@ func TestStartGame_InScoringState(t *testing.T) {
 	game := test.GameInScoringState()
 	models.GetGameProvider().SaveGame(game)
 	// After clicking on start game, the game should go to decoy prompt creation for the next drawing
-	gameStatus, err := StartGame(game.GroupName, *game.GetHostName())
+	gameStatus, err := StartGame(context.Background(), game.GroupName, *game.GetHostName())
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
 	assert.EqualValues(t, models.DecoyPromptCreation, gameStatus.CurrentState)
@@ -232,6 +901,7 @@ func TestStartGame_InScoringState(t *testing.T) {
 
 func TestStartGame_InScoringState_StartsNewRoundAfterScoringAllDrawings(t *testing.T) {
 	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
 	game := test.GameInScoringState()
 	activeDrawing := game.GetActiveDrawing()
 	// Mark all drawings that are not the active one as scored
@@ -242,8 +912,584 @@ func TestStartGame_InScoringState_StartsNewRoundAfterScoringAllDrawings(t *testi
 	}
 	models.GetGameProvider().SaveGame(game)
 	// After clicking on start game, the game should go to initial prompt creation for another round of drawings
-	gameStatus, err := StartGame(game.GroupName, *game.GetHostName())
+	gameStatus, err := StartGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	assert.NotNil(t, gameStatus)
+	assert.EqualValues(t, models.InitialPromptCreation, gameStatus.CurrentState)
+}
+
+func TestPauseGame_Host_BlocksActions(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := PauseGame(context.Background(), game.GroupName, *game.GetHostName(), "dinner time")
+	assert.Nil(t, err)
+	assert.True(t, gameStatus.Paused)
+	assert.Equal(t, "dinner time", gameStatus.PauseReason)
+	assert.EqualValues(t, models.InitialPromptCreation, gameStatus.CurrentState)
+	// Actions are rejected while the game is paused
+	gameStatus, err = AddPrompt(context.Background(), game.Players[1].Name, game.GroupName, "tuna", "stinky", "yummy")
+	assert.Equal(t, ErrGamePaused, err)
+	assert.Nil(t, gameStatus)
+	assert.Empty(t, game.OriginalPrompts)
+	// But players can still see the game's status and rejoin it
+	gameStatus, err = AddPlayer(context.Background(), game.Players[1].Name, game.GroupName, false)
+	assert.Nil(t, err)
+	assert.True(t, gameStatus.Players[1].HasPendingAction)
+	_, err = AddPlayer(context.Background(), "new cat", game.GroupName, false)
+	assert.Equal(t, ErrGamePaused, err)
+}
+
+func TestPauseGame_NonHost_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := PauseGame(context.Background(), game.GroupName, game.Players[1].Name, "")
+	assert.NotNil(t, err)
+	assert.Nil(t, gameStatus)
+	assert.False(t, game.Paused)
+}
+
+func TestPauseGame_AlreadyPaused_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	game.Paused = true
+	models.GetGameProvider().SaveGame(game)
+	_, err := PauseGame(context.Background(), game.GroupName, *game.GetHostName(), "")
+	assert.NotNil(t, err)
+}
+
+func TestResumeGame_Host_AllowsActions(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	game.Paused = true
+	game.PauseReason = "phone call"
+	models.GetGameProvider().SaveGame(game)
+	// Only the host can resume the game
+	_, err := ResumeGame(context.Background(), game.GroupName, game.Players[2].Name)
+	assert.NotNil(t, err)
+	gameStatus, err := ResumeGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	assert.False(t, gameStatus.Paused)
+	assert.Empty(t, gameStatus.PauseReason)
+	gameStatus, err = CastVote(context.Background(), game.Players[2].Name, game.GroupName, game.Drawings[0].OriginalPrompt.Identifier)
 	assert.Nil(t, err)
 	assert.NotNil(t, gameStatus)
+}
+
+func TestResumeGame_NotPaused_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := ResumeGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.NotNil(t, err)
+}
+
+func TestStartGame_InScoringState_EndsGameAfterLastRound(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInScoringState()
+	game.Settings.RoundCount = 2
+	game.CompletedRounds = 1
+	activeDrawing := game.GetActiveDrawing()
+	for _, drawing := range game.Drawings {
+		if drawing != activeDrawing {
+			drawing.Scored = true
+		}
+	}
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := StartGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.GameOver, gameStatus.CurrentState)
+	assert.EqualValues(t, 2, game.CompletedRounds)
+	// Final scores and the round's drawings are shown once the game is over
+	assert.EqualValues(t, 3, (*gameStatus.PointStandings)["player1"].TotalScore)
+	assert.Len(t, gameStatus.PastDrawings, len(game.Drawings))
+	// The game is marked as finished in the archive
+	transcript, err := GetGameTranscript(context.Background(), game.GroupName, game.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, transcript.FinishedAt)
+	assert.EqualValues(t, 2, transcript.Rounds[0].Number)
+}
+
+func TestStartGame_InScoringState_ArchivesCompletedRound(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInScoringState()
+	activeDrawing := game.GetActiveDrawing()
+	for _, drawing := range game.Drawings {
+		if drawing != activeDrawing {
+			drawing.Scored = true
+		}
+	}
+	models.GetGameProvider().SaveGame(game)
+	_, err := StartGame(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	history, err := GetGameHistory(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, game.ID, history[0].GameID)
+	assert.Equal(t, 1, history[0].RoundCount)
+	assert.Nil(t, history[0].FinishedAt)
+	transcript, err := GetGameTranscript(context.Background(), game.GroupName, game.ID)
+	assert.Nil(t, err)
+	expectedPlayers := []*archive.PlayerScore{
+		{Name: "player1", Points: 3},
+		{Name: "player2", Points: 1},
+		{Name: "player3", Points: 0},
+	}
+	assert.EqualValues(t, expectedPlayers, transcript.Players)
+	assert.Len(t, transcript.Rounds, 1)
+	archivedDrawing := transcript.Rounds[0].Drawings[0]
+	assert.Equal(t, "player2", archivedDrawing.Author)
+	assert.Equal(t, "chicken", archivedDrawing.OriginalPrompt.Noun)
+	assert.Equal(t, "player1", archivedDrawing.DecoyPrompts[0].Author)
+	assert.Equal(t, "player3", archivedDrawing.DecoyPrompts[1].Author)
+	expectedVotes := []*archive.Vote{
+		{Player: "player1", PromptIdentifier: activeDrawing.OriginalPrompt.Identifier},
+		{Player: "player3", PromptIdentifier: activeDrawing.DecoyPrompts["player3"].Identifier},
+	}
+	assert.EqualValues(t, expectedVotes, archivedDrawing.Votes)
+	expectedPoints := []*archive.Points{
+		{Player: "player1", Amount: 3, Reason: string(ChoseCorrectPrompt), CausingPlayer: "player1"},
+		{Player: "player2", Amount: 1, Reason: string(OtherChosePromptDrawn), CausingPlayer: "player1"},
+	}
+	assert.EqualValues(t, expectedPoints, archivedDrawing.Points)
+}
+
+func TestGetGameTranscript_Missing_Fails(t *testing.T) {
+	test.SetupTestArchiveStore(t)
+	transcript, err := GetGameTranscript(context.Background(), "group", "123")
+	assert.Equal(t, archive.ErrTranscriptNotFound, err)
+	assert.Nil(t, transcript)
+}
+
+func TestRematch_Host_ResetsGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInGameOverState()
+	game.Settings.AllowSelfVotes = true
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := Rematch(context.Background(), game.GroupName, *game.GetHostName())
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.WaitingForPlayers, gameStatus.CurrentState)
+	expectedPlayers := []*Player{
+		{Name: "player1", Host: true},
+		{Name: "player2"},
+		{Name: "player3"},
+	}
+	assert.EqualValues(t, expectedPlayers, gameStatus.Players)
+	// The new game keeps the settings but none of the previous game's data
+	rematch := models.GetGameProvider().LoadGame(game.GroupName)
+	assert.Equal(t, game.Settings, rematch.Settings)
+	assert.Empty(t, rematch.OriginalPrompts)
+	assert.Empty(t, rematch.GeneratedPrompts)
+	assert.Empty(t, rematch.Drawings)
+	assert.EqualValues(t, 0, rematch.CompletedRounds)
+	assert.NotEqual(t, game.ID, rematch.ID)
+	// The finished game keeps its final scores
+	assert.EqualValues(t, 3, game.Players[0].Points)
+}
+
+func TestCreateGroup_DefaultRoundCount_GameEndsAndRematches(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	test.SetupTestArchiveStore(t)
+	ctx := context.Background()
+	playerNames := []string{"mama cat", "papa cat", "baby cat"}
+	// Clients don't send a round count, games still have to end so players can rematch
+	assert.Nil(t, CreateGroup(ctx, "cats", models.GameSettings{}))
+	for i, playerName := range playerNames {
+		_, err := AddPlayer(ctx, playerName, "cats", i == 0)
+		assert.Nil(t, err)
+	}
+	_, err := StartGame(ctx, "cats", "mama cat")
+	assert.Nil(t, err)
+	game := models.GetGameProvider().LoadGame("cats")
+	assert.EqualValues(t, DefaultRoundCount, game.Settings.RoundCount)
+	for steps := 0; game.CurrentState != models.GameOver && steps < 100; steps++ {
+		activeDrawing := game.GetActiveDrawing()
+		for _, playerName := range playerNames {
+			switch game.CurrentState {
+			case models.InitialPromptCreation:
+				AddPrompt(ctx, playerName, "cats", "tuna", "stinky", "yummy")
+			case models.DrawingsInProgress:
+				SubmitDrawing(ctx, playerName, "cats", test.MockImageData)
+			case models.DecoyPromptCreation:
+				if playerName != activeDrawing.Author {
+					AddPrompt(ctx, playerName, "cats", "decoy", "fishy", playerName)
+				}
+			case models.Voting:
+				if playerName != activeDrawing.Author {
+					CastVote(ctx, playerName, "cats", activeDrawing.OriginalPrompt.Identifier)
+				}
+			}
+		}
+		if game.CurrentState == models.Scoring {
+			StartGame(ctx, "cats", "mama cat")
+		}
+		game = models.GetGameProvider().LoadGame("cats")
+	}
+	assert.EqualValues(t, models.GameOver, game.CurrentState)
+	assert.EqualValues(t, DefaultRoundCount, game.CompletedRounds)
+	gameStatus, err := Rematch(ctx, "cats", "mama cat")
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.WaitingForPlayers, gameStatus.CurrentState)
+}
+
+func TestRematch_NonHost_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInGameOverState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := Rematch(context.Background(), game.GroupName, game.Players[1].Name)
+	assert.NotNil(t, err)
+	assert.Nil(t, gameStatus)
+	assert.Equal(t, game, models.GetGameProvider().LoadGame(game.GroupName))
+}
+
+func TestRematch_GameNotOver_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInScoringState()
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := Rematch(context.Background(), game.GroupName, *game.GetHostName())
+	assert.NotNil(t, err)
+	assert.Nil(t, gameStatus)
+	assert.EqualValues(t, models.Scoring, game.CurrentState)
+}
+
+func TestListGames_SortsByGroupName(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	for _, groupName := range []string{"zebras", "cats", "moles"} {
+		CreateGroup(context.Background(), groupName, models.GameSettings{})
+	}
+	games := ListGames()
+	assert.Len(t, games, 3)
+	assert.Equal(t, "cats", games[0].GroupName)
+	assert.Equal(t, "moles", games[1].GroupName)
+	assert.Equal(t, "zebras", games[2].GroupName)
+}
+
+func TestGetGame_MissingGroup_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game, err := GetGame(context.Background(), "cats")
+	assert.Equal(t, ErrGameNotFound, err)
+	assert.Nil(t, game)
+}
+
+func TestAdvanceGame_WaitingForPlayers_StartsGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	advancedGame, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.InitialPromptCreation, advancedGame.CurrentState)
+}
+
+func TestAdvanceGame_InitialPromptCreation_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	models.GetGameProvider().SaveGame(game)
+	advancedGame, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+	assert.Nil(t, advancedGame)
+	assert.EqualValues(t, models.InitialPromptCreation, game.CurrentState)
+}
+
+func TestAdvanceGame_DrawingsInProgress_SubmitsMissingDrawings(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	game := test.GameInDrawingsInProgressState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := SubmitStrokeDrawing(context.Background(), "player1", game.GroupName, []*Stroke{{Color: "#000000", Width: 2, Points: []StrokePoint{{X: 1, Y: 1}}}})
+	assert.Nil(t, err)
+	advancedGame, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DecoyPromptCreation, advancedGame.CurrentState)
+	assert.Len(t, advancedGame.Drawings, 3)
+	assert.Equal(t, "player1", advancedGame.Drawings[0].Author)
+}
+
+func TestAdvanceGame_Voting_ScoresVotesSoFar(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	advancedGame, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.Scoring, advancedGame.CurrentState)
+}
+
+func TestAdvanceGame_Paused_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInDecoyPromptCreationState()
+	game.Paused = true
+	models.GetGameProvider().SaveGame(game)
+	advancedGame, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Equal(t, ErrGamePaused, err)
+	assert.Nil(t, advancedGame)
+	assert.EqualValues(t, models.DecoyPromptCreation, game.CurrentState)
+}
+
+func TestAdvanceGame_GameOver_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestArchiveStore(t)
+	game := test.GameInGameOverState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := AdvanceGame(context.Background(), game.GroupName)
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+}
+
+func TestResetGame_KeepsPlayers(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	resetGame, err := ResetGame(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	// Operators get a copy of the game that was saved
+	assert.Equal(t, resetGame.ID, models.GetGameProvider().LoadGame(game.GroupName).ID)
+	assert.EqualValues(t, models.WaitingForPlayers, resetGame.CurrentState)
+	assert.Len(t, resetGame.Players, len(game.Players))
+	assert.Empty(t, resetGame.Drawings)
+	assert.NotEqual(t, game.ID, resetGame.ID)
+}
+
+func TestDeleteGame_RemovesGameAndSignalsWatchers(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	changes, stop := WatchGame(game.GroupName)
+	defer stop()
+	err := DeleteGame(context.Background(), game.GroupName)
+	assert.Nil(t, err)
+	assert.Nil(t, models.GetGameProvider().LoadGame(game.GroupName))
+	assert.Len(t, changes, 1)
+	assert.Equal(t, ErrGameNotFound, DeleteGame(context.Background(), game.GroupName))
+}
+
+func TestLoadFixture_ReachesEveryState(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	test.SetupTestArchiveStore(t)
+	playerNames := []string{"mama cat", "papa cat", "baby cat", "kitten", "old cat"}
+	gameStates := []models.GameState{
+		models.WaitingForPlayers,
+		models.InitialPromptCreation,
+		models.DrawingsInProgress,
+		models.DecoyPromptCreation,
+		models.Voting,
+		models.Scoring,
+		models.GameOver,
+	}
+	for _, gameState := range gameStates {
+		gameStatus, err := LoadFixture(context.Background(), "cats", gameState, playerNames)
+		assert.Nil(t, err, gameState)
+		assert.EqualValues(t, gameState, gameStatus.CurrentState)
+		assert.Equal(t, "mama cat", gameStatus.CurrentPlayer.Name)
+		assert.True(t, gameStatus.CurrentPlayer.IsHost)
+		game := models.GetGameProvider().LoadGame("cats")
+		assert.Len(t, game.Players, len(playerNames))
+		assert.EqualValues(t, gameState, game.CurrentState)
+	}
+	// Players get points for fooling each other once the votes are scored
+	game := models.GetGameProvider().LoadGame("cats")
+	for _, drawing := range game.Drawings {
+		assert.Len(t, drawing.DecoyPrompts, len(playerNames)-1)
+		assert.Len(t, drawing.Votes, len(playerNames)-1)
+	}
+	assert.NotZero(t, game.Players[1].Points)
+}
+
+func TestLoadFixture_GameOver_NotArchived(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	test.SetupTestArchiveStore(t)
+	_, err := LoadFixture(context.Background(), "cats", models.GameOver, []string{"mama cat", "papa cat", "baby cat"})
+	assert.Nil(t, err)
+	summaries, err := archive.GetStore().ListTranscripts("cats")
+	assert.Nil(t, err)
+	assert.Empty(t, summaries)
+	// Nor are the games played after it
+	_, err = Rematch(context.Background(), "cats", "mama cat")
+	assert.Nil(t, err)
+	assert.True(t, models.GetGameProvider().LoadGame("cats").Fixture)
+}
+
+func TestLoadFixture_ReplacesGroupGame(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInVotingState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := LoadFixture(context.Background(), game.GroupName, models.WaitingForPlayers, []string{"a", "b", "c"})
+	assert.Nil(t, err)
+	loadedGame := models.GetGameProvider().LoadGame(game.GroupName)
+	assert.NotEqual(t, game, loadedGame)
+	assert.EqualValues(t, models.WaitingForPlayers, loadedGame.CurrentState)
+}
+
+func TestLoadFixture_InvalidFixture_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	_, err := LoadFixture(context.Background(), "cats", models.Voting, []string{"a", "b"})
+	assert.Equal(t, ErrorCodeNotEnoughPlayers, ErrorCodeOf(err))
+	_, err = LoadFixture(context.Background(), "cats", models.GameState("Napping"), []string{"a", "b", "c"})
+	assert.Equal(t, ErrorCodeInvalidInput, ErrorCodeOf(err))
+	_, err = LoadFixture(context.Background(), "cats", models.Voting, []string{"a", "b", "a"})
+	assert.Equal(t, ErrorCodeInvalidInput, ErrorCodeOf(err))
+	assert.Nil(t, models.GetGameProvider().LoadGame("cats"))
+}
+
+// Tests pick how long bots wait for their turn, it goes back to what it was afterwards
+func setupTestBotDelay(t *testing.T, delay time.Duration) {
+	previousDelay := GetBotDelay()
+	SetBotDelay(delay)
+	t.Cleanup(func() {
+		SetBotDelay(previousDelay)
+	})
+}
+
+func TestAddBot_Host_AddsBot(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	CreateGroup(context.Background(), "cats", models.GameSettings{})
+	AddPlayer(context.Background(), "mama cat", "cats", true)
+	AddBot(context.Background(), "cats", "mama cat")
+	gameStatus, err := AddBot(context.Background(), "cats", "mama cat")
+	assert.Nil(t, err)
+	assert.Len(t, gameStatus.Players, 3)
+	assert.Equal(t, &Player{Name: botNames[0], Bot: true}, gameStatus.Players[1])
+	assert.Equal(t, &Player{Name: botNames[1], Bot: true}, gameStatus.Players[2])
+	// There are enough players to start now
+	gameStatus, err = StartGame(context.Background(), "cats", "mama cat")
+	assert.Nil(t, err)
 	assert.EqualValues(t, models.InitialPromptCreation, gameStatus.CurrentState)
 }
+
+func TestAddBot_NonHost_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInWaitingForPlayersState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := AddBot(context.Background(), game.GroupName, game.Players[1].Name)
+	assert.Equal(t, ErrorCodeNotHost, ErrorCodeOf(err))
+}
+
+func TestAddBot_GameStarted_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	game := test.GameInInitialPromptCreationState()
+	models.GetGameProvider().SaveGame(game)
+	_, err := AddBot(context.Background(), game.GroupName, game.Players[0].Name)
+	assert.Equal(t, ErrorCodeWrongState, ErrorCodeOf(err))
+}
+
+func TestAddPlayer_BotName_Fails(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	CreateGroup(context.Background(), "cats", models.GameSettings{})
+	AddPlayer(context.Background(), "mama cat", "cats", true)
+	AddBot(context.Background(), "cats", "mama cat")
+	_, err := AddPlayer(context.Background(), botNames[0], "cats", false)
+	assert.Equal(t, ErrorCodeInvalidInput, ErrorCodeOf(err))
+}
+
+func TestBots_PlayARoundWithPlayers(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	test.SetupTestDrawingStore(t)
+	setupTestBotDelay(t, 0)
+	CreateGroup(context.Background(), "cats", models.GameSettings{})
+	AddPlayer(context.Background(), "mama cat", "cats", true)
+	AddPlayer(context.Background(), "papa cat", "cats", false)
+	AddBot(context.Background(), "cats", "mama cat")
+	StartGame(context.Background(), "cats", "mama cat")
+	for _, playerName := range []string{"mama cat", "papa cat"} {
+		_, err := AddPrompt(context.Background(), playerName, "cats", "tuna", "stinky", "yummy")
+		assert.Nil(t, err)
+	}
+	// The bot wrote its prompt as soon as the game was loaded
+	gameStatus, err := GetGameState(context.Background(), "cats", "mama cat")
+	assert.Nil(t, err)
+	assert.EqualValues(t, models.DrawingsInProgress, gameStatus.CurrentState)
+	for _, playerName := range []string{"mama cat", "papa cat"} {
+		_, err = SubmitDrawing(context.Background(), playerName, "cats", test.MockImageData)
+		assert.Nil(t, err)
+	}
+	gameStatus, _ = GetGameState(context.Background(), "cats", "mama cat")
+	assert.EqualValues(t, models.DecoyPromptCreation, gameStatus.CurrentState)
+	game := models.GetGameProvider().LoadGame("cats")
+	assert.Len(t, game.Drawings, 3)
+	// Players write decoys and vote for every drawing, the bot does for the ones that aren't its own
+	for game.CurrentState != models.GameOver && game.CurrentState != models.InitialPromptCreation {
+		activeDrawing := game.GetActiveDrawing()
+		for _, playerName := range []string{"mama cat", "papa cat"} {
+			if playerName == activeDrawing.Author {
+				continue
+			}
+			switch game.CurrentState {
+			case models.DecoyPromptCreation:
+				AddPrompt(context.Background(), playerName, "cats", "decoy", playerName, "words")
+			case models.Voting:
+				CastVote(context.Background(), playerName, "cats", activeDrawing.OriginalPrompt.Identifier)
+			}
+		}
+		if game.CurrentState == models.Scoring {
+			StartGame(context.Background(), "cats", "mama cat")
+		}
+		GetGameState(context.Background(), "cats", "mama cat")
+		game = models.GetGameProvider().LoadGame("cats")
+	}
+	assert.EqualValues(t, models.InitialPromptCreation, game.CurrentState)
+	assert.EqualValues(t, 1, game.CompletedRounds)
+}
+
+func TestBots_WaitForTheirTurn(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	setupTestBotDelay(t, time.Hour)
+	game := test.GameInInitialPromptCreationState()
+	game.Players = append(game.Players, &models.Player{Name: botNames[0], Bot: true}, &models.Player{Name: botNames[1], Bot: true})
+	models.GetGameProvider().SaveGame(game)
+	gameStatus, err := AddPrompt(context.Background(), "player1", game.GroupName, "tuna", "stinky", "yummy")
+	assert.Nil(t, err)
+	assert.Len(t, game.OriginalPrompts, 1)
+	// Bots act one after the other
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *gameStatus.BotTurnAt, time.Minute)
+	startedAt := game.BotTurnsStartedAt.Add(-90 * time.Minute)
+	game.BotTurnsStartedAt = &startedAt
+	gameStatus, _ = GetGameState(context.Background(), game.GroupName, "player1")
+	assert.Len(t, game.OriginalPrompts, 2)
+	assert.Equal(t, botNames[0], game.OriginalPrompts[1].Author)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), *gameStatus.BotTurnAt, time.Minute)
+}
+
+func TestBots_ConcurrentReads_TakeEachTurnOnce(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	setupTestBotDelay(t, time.Hour)
+	game := test.GameInInitialPromptCreationState()
+	game.Players = append(game.Players, &models.Player{Name: botNames[0], Bot: true}, &models.Player{Name: botNames[1], Bot: true})
+	startedAt := time.Now().Add(-3 * time.Hour)
+	game.BotTurnsStartedAt = &startedAt
+	models.GetGameProvider().SaveGame(game)
+	var wait sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			GetGameState(context.Background(), game.GroupName, "player1")
+		}()
+	}
+	wait.Wait()
+	game = models.GetGameProvider().LoadGame(game.GroupName)
+	assert.Len(t, game.OriginalPrompts, 2)
+	assert.Equal(t, botNames[0], game.OriginalPrompts[0].Author)
+	assert.Equal(t, botNames[1], game.OriginalPrompts[1].Author)
+}
+
+func TestBots_PausedGame_Wait(t *testing.T) {
+	test.SetupTestGameProvider(t)
+	setupTestBotDelay(t, 0)
+	game := test.GameInInitialPromptCreationState()
+	game.Players = append(game.Players, &models.Player{Name: botNames[0], Bot: true})
+	models.GetGameProvider().SaveGame(game)
+	_, err := PauseGame(context.Background(), game.GroupName, "player1", "")
+	assert.Nil(t, err)
+	gameStatus, _ := GetGameState(context.Background(), game.GroupName, "player1")
+	assert.Nil(t, gameStatus.BotTurnAt)
+	assert.Empty(t, game.OriginalPrompts)
+	ResumeGame(context.Background(), game.GroupName, "player1")
+	GetGameState(context.Background(), game.GroupName, "player1")
+	assert.Len(t, game.OriginalPrompts, 1)
+}
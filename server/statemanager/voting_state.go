@@ -2,10 +2,13 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 	"sort"
 )
 
+// ErrVotedForOwnDecoy is returned when a player votes for the decoy prompt they wrote and
+// the game's settings don't allow it
+var ErrVotedForOwnDecoy = newError(ErrorCodeVotedForOwnDecoy, "Players cannot vote for their own decoy prompt")
+
 type votingState struct {
 	game *models.Game
 }
@@ -15,38 +18,46 @@ func (state votingState) addPlayer(player *models.Player) error {
 	if state.game.IsPlayerInGame(player.Name) {
 		return nil
 	}
-	return errors.New("Cannot add new players to a game in this state")
+	return newError(ErrorCodeWrongState, "Cannot add new players to a game in this state")
 }
 
 func (state votingState) startGame(groupName string, playerName string) error {
-	return errors.New("startGame not supported for voting state")
+	return newError(ErrorCodeWrongState, "startGame not supported for voting state")
+}
+
+func (state votingState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Submitting drawings is not allowed in the voting state")
 }
 
-func (state votingState) submitDrawing(playerName string, encodedImage string) error {
-	return errors.New("Submitting drawings is not allowed in the voting state")
+func (state votingState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
 }
 
 func (state votingState) addPrompt(prompts *models.Prompt) error {
-	return errors.New("addprompts not supported for voting state")
+	return newError(ErrorCodeWrongState, "addprompts not supported for voting state")
 }
 
 func (state votingState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("There is no active drawing available for this state")
+		return newError(ErrorCodeWrongState, "There is no active drawing available for this state")
 	}
 
 	// Get all available prompts for the drawing
 	prompts := make([]*Prompt, 0, len(state.game.Players))
 	prompts = append(prompts, makeResponsePromptFromModelPrompt(activeDrawing.OriginalPrompt))
-	for _, decoyPrompt := range activeDrawing.DecoyPrompts {
+	for author, decoyPrompt := range activeDrawing.DecoyPrompts {
+		// Hide the player's own decoy unless they're allowed to vote for it
+		if author == player.Name && !state.game.Settings.AllowSelfVotes {
+			continue
+		}
 		prompts = append(prompts, makeResponsePromptFromModelPrompt(decoyPrompt))
 	}
 	// Sort the prompts by prompt id
 	sort.Slice(prompts, func(i, j int) bool { return prompts[i].Identifier < prompts[j].Identifier })
 	gameStatus.CurrentDrawing = &Drawing{
-		ImageData: activeDrawing.ImageData,
-		Prompts:   prompts,
+		ImageURL: drawingImageURL(activeDrawing.ImageID),
+		Prompts:  prompts,
 	}
 	// Mark players who haven't submitted their votes as having pending actions
 	casterToVoteMap := map[string]*models.Vote{}
@@ -76,18 +87,34 @@ func makeResponsePromptFromModelPrompt(prompt *models.Prompt) *Prompt {
 func (state votingState) castVote(player *models.Player, promptIdentifier string) error {
 	activeDrawing := state.game.GetActiveDrawing()
 	if activeDrawing == nil {
-		return errors.New("There is no active drawing available for this state")
+		return newError(ErrorCodeWrongState, "There is no active drawing available for this state")
+	}
+	if activeDrawing.Author == player.Name {
+		return newError(ErrorCodeWrongState, "Players can't vote on their own drawing")
 	}
 
 	prompt := activeDrawing.GetPromptWithIdentifier(promptIdentifier)
 	if prompt == nil {
-		return errors.New("Could not find the chosen prompt in the active drawing")
+		return newError(ErrorCodeInvalidInput, "Could not find the chosen prompt in the active drawing")
+	}
+	if prompt == activeDrawing.DecoyPrompts[player.Name] && !state.game.Settings.AllowSelfVotes {
+		return ErrVotedForOwnDecoy
 	}
 
 	activeDrawing.Votes[player.Name] = &models.Vote{Player: player, SelectedPrompt: prompt}
 	// If all players have voted move to the scoring state
 	if len(activeDrawing.Votes) == len(state.game.Players)-1 {
-		state.game.CurrentState = models.Scoring
+		return state.startScoring()
 	}
 	return nil
 }
+
+// The votes cast so far are scored, players who didn't vote don't get points for guessing
+func (state votingState) startScoring() error {
+	state.game.CurrentState = models.Scoring
+	return nil
+}
+
+func (state votingState) advance() error {
+	return state.startScoring()
+}
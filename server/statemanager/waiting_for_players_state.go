@@ -2,7 +2,6 @@ package statemanager
 
 import (
 	"drawydraw/models"
-	"errors"
 )
 
 type waitingForPlayersState struct {
@@ -15,23 +14,32 @@ func (state waitingForPlayersState) addPlayer(player *models.Player) error {
 }
 
 func (state waitingForPlayersState) startGame(groupName string, playerName string) error {
-	if playerName != *state.game.GetHostName() {
-		return errors.New("only the host can start a game")
+	hostName := state.game.GetHostName()
+	if hostName == nil || playerName != *hostName {
+		return newError(ErrorCodeNotHost, "only the host can start a game")
 	}
+	return state.start()
+}
+
+func (state waitingForPlayersState) start() error {
 	// The game doesn't make any sense with less than 3 players
 	if len(state.game.Players) < 3 {
-		return errors.New("3 is the minimum number of players to play the game")
+		return newError(ErrorCodeNotEnoughPlayers, "3 is the minimum number of players to play the game")
 	}
 	state.game.CurrentState = models.InitialPromptCreation
 	return nil
 }
 
-func (state waitingForPlayersState) submitDrawing(playerName string, encodedImage string) error {
-	return errors.New("Submitting drawings is not allowed in the wating for players state")
+func (state waitingForPlayersState) submitDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Submitting drawings is not allowed in the wating for players state")
+}
+
+func (state waitingForPlayersState) saveDraftDrawing(drawing *models.Drawing, pngImage []byte) error {
+	return newError(ErrorCodeWrongState, "Drafts can only be saved while players are drawing")
 }
 
 func (state waitingForPlayersState) addPrompt(prompts *models.Prompt) error {
-	return errors.New("addPrompt not supported for waiting for players state")
+	return newError(ErrorCodeWrongState, "addPrompt not supported for waiting for players state")
 }
 
 func (state waitingForPlayersState) addGameStatusPropertiesForPlayer(player *models.Player, gameStatus *GameStatusResponse) error {
@@ -39,5 +47,9 @@ func (state waitingForPlayersState) addGameStatusPropertiesForPlayer(player *mod
 }
 
 func (state waitingForPlayersState) castVote(player *models.Player, promptIdentifier string) error {
-	return errors.New("Casting votes is not allowed at this stage of the game")
+	return newError(ErrorCodeWrongState, "Casting votes is not allowed at this stage of the game")
+}
+
+func (state waitingForPlayersState) advance() error {
+	return state.start()
 }
@@ -0,0 +1,53 @@
+package statemanager
+
+import (
+	"context"
+	"drawydraw/models"
+	"sync"
+)
+
+// Watchers get a signal on their channel whenever their group's game changes,
+// signals that arrive before the last one was received are merged into it
+var watchers = struct {
+	sync.Mutex
+	byGroup map[string]map[chan struct{}]bool
+}{byGroup: map[string]map[chan struct{}]bool{}}
+
+// WatchGame signals the returned channel whenever a group's game is changed, call stop when done watching
+func WatchGame(groupName string) (changes <-chan struct{}, stop func()) {
+	watcher := make(chan struct{}, 1)
+	watchers.Lock()
+	defer watchers.Unlock()
+	if watchers.byGroup[groupName] == nil {
+		watchers.byGroup[groupName] = map[chan struct{}]bool{}
+	}
+	watchers.byGroup[groupName][watcher] = true
+	return watcher, func() {
+		watchers.Lock()
+		defer watchers.Unlock()
+		delete(watchers.byGroup[groupName], watcher)
+		if len(watchers.byGroup[groupName]) == 0 {
+			delete(watchers.byGroup, groupName)
+		}
+	}
+}
+
+// Every change to a game is saved through here so watchers hear about it and bots know when to take their turns
+func saveGame(ctx context.Context, game *models.Game) {
+	trackPhase(ctx, game)
+	recordState(ctx, game)
+	scheduleBotTurns(game)
+	models.GetGameProvider().SaveGame(game)
+	notifyWatchers(game.GroupName)
+}
+
+func notifyWatchers(groupName string) {
+	watchers.Lock()
+	defer watchers.Unlock()
+	for watcher := range watchers.byGroup[groupName] {
+		select {
+		case watcher <- struct{}{}:
+		default:
+		}
+	}
+}
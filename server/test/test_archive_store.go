@@ -0,0 +1,15 @@
+package test
+
+import (
+	"drawydraw/archive"
+	"testing"
+)
+
+// SetupTestArchiveStore sets up a clean in-memory archive store and tears it down after the test finishes
+func SetupTestArchiveStore(t *testing.T) {
+	previousStore := archive.GetStore()
+	archive.SetStore(archive.NewMemoryStore())
+	t.Cleanup(func() {
+		archive.SetStore(previousStore)
+	})
+}
@@ -0,0 +1,18 @@
+package test
+
+import (
+	"drawydraw/images"
+	"testing"
+)
+
+// MockImageData is a 1x1 red PNG data URL to submit as a drawing in tests
+const MockImageData = "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR4nGP4z8DwHwAFAAH/iZk9HQAAAABJRU5ErkJggg=="
+
+// SetupTestDrawingStore sets up a clean in-memory drawing store and tears it down after the test finishes
+func SetupTestDrawingStore(t *testing.T) {
+	previousStore := images.GetDrawingStore()
+	images.SetDrawingStore(images.NewMemoryDrawingStore())
+	t.Cleanup(func() {
+		images.SetDrawingStore(previousStore)
+	})
+}
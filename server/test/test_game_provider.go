@@ -1,14 +1,19 @@
 package test
 
 import (
+	"context"
 	"drawydraw/models"
+	"sync"
 	"testing"
 )
 
 // TestGameProvider facilitates testing by having a simple implementation that doesn't
-// involve caches or external calls.
+// involve caches or external calls. It can be used by concurrent requests, like the ones simulations send.
+// Its health check fails with the error set by SetHealthError.
 type TestGameProvider struct {
-	games map[string]*models.Game
+	mutex       sync.RWMutex
+	games       map[string]*models.Game
+	healthError error
 }
 
 func NewTestGameProvider() *TestGameProvider {
@@ -16,14 +21,48 @@ func NewTestGameProvider() *TestGameProvider {
 }
 
 func (provider *TestGameProvider) LoadGame(groupName string) *models.Game {
+	provider.mutex.RLock()
+	defer provider.mutex.RUnlock()
 	return provider.games[groupName]
 }
 
 func (provider *TestGameProvider) SaveGame(game *models.Game) error {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
 	provider.games[game.GroupName] = game
 	return nil
 }
 
+func (provider *TestGameProvider) ListGames() []*models.Game {
+	provider.mutex.RLock()
+	defer provider.mutex.RUnlock()
+	games := make([]*models.Game, 0, len(provider.games))
+	for _, game := range provider.games {
+		games = append(games, game)
+	}
+	return games
+}
+
+func (provider *TestGameProvider) DeleteGame(groupName string) error {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	delete(provider.games, groupName)
+	return nil
+}
+
+func (provider *TestGameProvider) CheckHealth(ctx context.Context) error {
+	provider.mutex.RLock()
+	defer provider.mutex.RUnlock()
+	return provider.healthError
+}
+
+// SetHealthError makes the provider's health check fail with an error, or pass again when it's nil
+func (provider *TestGameProvider) SetHealthError(err error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	provider.healthError = err
+}
+
 // SetupTestGameProvider sets up a clean test game provider and tears it down after the test finishes
 func SetupTestGameProvider(t *testing.T) {
 	previousProvider := models.GetGameProvider()
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"drawydraw/images"
 	"drawydraw/models"
 )
 
@@ -47,24 +48,25 @@ func GameInDrawingsInProgressState() *models.Game {
 // DecoyPromptCreation describes a game where players are creating decoy prompts
 func GameInDecoyPromptCreationState() *models.Game {
 	game := GameInDrawingsInProgressState()
-	mockImageData := "data:image/bmp;base64,Qk0eAAAAAAAAABoAAAAMAAAAAQABAAEAGAAAAP8A"
+	// Drawings only reference their image so it doesn't need to be in the drawing store
+	mockImageID := images.DrawingID([]byte("mock image data"))
 	drawings := []*models.Drawing{
 		{
-			ImageData:      mockImageData,
+			ImageID:        mockImageID,
 			Author:         "player2",
 			DecoyPrompts:   map[string]*models.Prompt{},
 			OriginalPrompt: game.GeneratedPrompts[0],
 			Votes:          map[string]*models.Vote{},
 		},
 		{
-			ImageData:      mockImageData,
+			ImageID:        mockImageID,
 			Author:         "player3",
 			DecoyPrompts:   map[string]*models.Prompt{},
 			OriginalPrompt: game.GeneratedPrompts[1],
 			Votes:          map[string]*models.Vote{},
 		},
 		{
-			ImageData:      mockImageData,
+			ImageID:        mockImageID,
 			Author:         "player1",
 			DecoyPrompts:   map[string]*models.Prompt{},
 			OriginalPrompt: game.GeneratedPrompts[2],
@@ -101,3 +103,17 @@ func GameInScoringState() *models.Game {
 	}
 	return game
 }
+
+// GameInGameOverState describes a game where the last round has been scored
+func GameInGameOverState() *models.Game {
+	game := GameInScoringState()
+	for _, drawing := range game.Drawings {
+		drawing.Scored = true
+	}
+	game.Players[0].Points = 3
+	game.Players[1].Points = 1
+	game.Settings.RoundCount = 1
+	game.CompletedRounds = 1
+	game.CurrentState = models.GameOver
+	return game
+}
@@ -0,0 +1,18 @@
+package test
+
+import (
+	"bytes"
+	"drawydraw/logging"
+	"testing"
+)
+
+// SetupTestLogger captures the messages logged at or above a level during the test and restores the logger after it finishes
+func SetupTestLogger(t *testing.T, level logging.Level) *bytes.Buffer {
+	previousLogger := logging.GetLogger()
+	output := &bytes.Buffer{}
+	logging.SetLogger(logging.NewLogger(output, level))
+	t.Cleanup(func() {
+		logging.SetLogger(previousLogger)
+	})
+	return output
+}
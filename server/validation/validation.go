@@ -0,0 +1,147 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Requests declare their rules with `validate` tags, using the rules of github.com/go-playground/validator
+// along with "name" and "word" for the characters allowed in names and prompts.
+// String fields tagged `normalize:"text"` are normalized before they're validated.
+var validate = newValidator()
+
+// FieldError describes why a field of a request is invalid
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Errors lists every invalid field of a request
+type Errors []*FieldError
+
+func (errs Errors) Error() string {
+	messages := make([]string, len(errs))
+	for i, fieldError := range errs {
+		messages[i] = fmt.Sprintf("%s %s", fieldError.Field, fieldError.Message)
+	}
+	return strings.Join(messages, ", ")
+}
+
+// Request normalizes the text fields of a request struct and then validates it, returning Errors if it's invalid
+func Request(request interface{}) error {
+	normalizeFields(reflect.ValueOf(request).Elem())
+	err := validate.Struct(request)
+	if err == nil {
+		return nil
+	}
+	validationErrors, isValidationErrors := err.(validator.ValidationErrors)
+	if !isValidationErrors {
+		return err
+	}
+	errs := make(Errors, len(validationErrors))
+	for i, validationError := range validationErrors {
+		errs[i] = &FieldError{
+			Field:   validationError.Field(),
+			Rule:    validationError.Tag(),
+			Message: messageForRule(validationError),
+		}
+	}
+	return errs
+}
+
+// Text puts text in Unicode normal form C, trims it and collapses runs of whitespace into single spaces,
+// so names and words that look the same are the same
+func Text(text string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(text)), " ")
+}
+
+func normalizeFields(request reflect.Value) {
+	requestType := request.Type()
+	for i := 0; i < requestType.NumField(); i++ {
+		field := request.Field(i)
+		if requestType.Field(i).Tag.Get("normalize") == "text" && field.Kind() == reflect.String {
+			field.SetString(Text(field.String()))
+		}
+	}
+}
+
+func newValidator() *validator.Validate {
+	newValidate := validator.New()
+	// Errors name fields the way clients send them
+	newValidate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "form", "uri"} {
+			name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]
+			if name != "" && name != "-" {
+				return name
+			}
+		}
+		return field.Name
+	})
+	newValidate.RegisterValidation("name", func(field validator.FieldLevel) bool {
+		return onlyHas(field.Field().String(), isNameRune)
+	})
+	newValidate.RegisterValidation("word", func(field validator.FieldLevel) bool {
+		return onlyHas(field.Field().String(), isWordRune)
+	})
+	newValidate.RegisterValidation("identifier", func(field validator.FieldLevel) bool {
+		return onlyHas(field.Field().String(), isIdentifierRune)
+	})
+	return newValidate
+}
+
+func onlyHas(text string, isAllowed func(rune) bool) bool {
+	for _, character := range text {
+		if !isAllowed(character) {
+			return false
+		}
+	}
+	return true
+}
+
+// Names can have letters from any language, numbers, spaces and a few punctuation marks
+func isNameRune(character rune) bool {
+	return isWordRune(character) || unicode.IsNumber(character) || strings.ContainsRune("._", character)
+}
+
+// Prompt words can only have letters, spaces, hyphens and apostrophes
+func isWordRune(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsMark(character) || strings.ContainsRune(" -'’", character)
+}
+
+// Identifiers the server makes up, like game IDs, only have ASCII letters, digits and hyphens
+func isIdentifierRune(character rune) bool {
+	return (character >= 'a' && character <= 'z') || (character >= 'A' && character <= 'Z') || (character >= '0' && character <= '9') || character == '-'
+}
+
+func messageForRule(validationError validator.FieldError) string {
+	isText := validationError.Kind() == reflect.String
+	switch validationError.Tag() {
+	case "required", "required_without":
+		return "is required"
+	case "max":
+		if isText {
+			return fmt.Sprintf("must be at most %s characters long", validationError.Param())
+		}
+		return fmt.Sprintf("must be at most %s", validationError.Param())
+	case "min":
+		if isText {
+			return fmt.Sprintf("must be at least %s characters long", validationError.Param())
+		}
+		return fmt.Sprintf("must be at least %s", validationError.Param())
+	case "numeric":
+		return "must be a number"
+	case "name":
+		return "can only contain letters, numbers, spaces and - ' . _"
+	case "word":
+		return "can only contain letters, spaces, hyphens and apostrophes"
+	case "identifier":
+		return "can only contain letters, numbers and hyphens"
+	}
+	return "is invalid"
+}
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRequest struct {
+	Name  string `json:"name" validate:"required,max=5,name" normalize:"text"`
+	Word  string `json:"word" validate:"omitempty,word" normalize:"text"`
+	Count int    `form:"count" validate:"max=3"`
+	Data  string `json:"data"`
+}
+
+func TestText(t *testing.T) {
+	// "José" written with a combining accent becomes the same as the precomposed one
+	assert.Equal(t, "José", Text("José"))
+	assert.Equal(t, "baby cat", Text("  baby \t  cat \n"))
+	assert.Equal(t, "", Text("   "))
+}
+
+func TestRequest_NormalizesTaggedFields(t *testing.T) {
+	request := &testRequest{Name: " José  ", Word: " ice   cream ", Data: " data "}
+	err := Request(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "José", request.Name)
+	assert.Equal(t, "ice cream", request.Word)
+	// Fields that aren't tagged are left alone
+	assert.Equal(t, " data ", request.Data)
+}
+
+func TestRequest_ListsInvalidFields(t *testing.T) {
+	err := Request(&testRequest{Name: "   ", Word: "r2d2", Count: 4})
+	expectedErrors := Errors{
+		{Field: "name", Rule: "required", Message: "is required"},
+		{Field: "word", Rule: "word", Message: "can only contain letters, spaces, hyphens and apostrophes"},
+		{Field: "count", Rule: "max", Message: "must be at most 3"},
+	}
+	assert.Equal(t, expectedErrors, err)
+	assert.Equal(t, "name is required, word can only contain letters, spaces, hyphens and apostrophes, count must be at most 3", err.Error())
+}
+
+func TestRequest_CountsCharactersNotBytes(t *testing.T) {
+	assert.Nil(t, Request(&testRequest{Name: "Ñandú"}))
+	err := Request(&testRequest{Name: "Ñandús"})
+	assert.Equal(t, Errors{{Field: "name", Rule: "max", Message: "must be at most 5 characters long"}}, err)
+	err = Request(&testRequest{Name: "<b>"})
+	assert.Equal(t, "name", err.(Errors)[0].Field)
+	assert.Equal(t, "name", err.(Errors)[0].Rule)
+}
+
+func TestRequest_Identifier(t *testing.T) {
+	type identifierRequest struct {
+		ID string `uri:"id" validate:"identifier"`
+	}
+	assert.Nil(t, Request(&identifierRequest{ID: "1589000000-42"}))
+	err := Request(&identifierRequest{ID: "../games"})
+	assert.Equal(t, Errors{{Field: "id", Rule: "identifier", Message: "can only contain letters, numbers and hyphens"}}, err)
+}